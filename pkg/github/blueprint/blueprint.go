@@ -0,0 +1,65 @@
+// Package blueprint declares a scheduled-sync configuration ("blueprint") for keeping
+// GitHub Projects V2 in line with a repository's issues and pull requests: a set of
+// connections (repos, orgs, or projects) and one set of transformation rules (label to
+// status-field mapping, automatic PR-to-project addition, issue type inference from a
+// title prefix) applied to all of them, re-applied on a cron schedule or on demand.
+package blueprint
+
+// Connection names one repo, org, or project that a blueprint's rules apply to. Exactly
+// one of Owner+Repo or Org should be set, alongside the ProjectID the items live on.
+type Connection struct {
+	Owner     string `json:"owner,omitempty"`
+	Repo      string `json:"repo,omitempty"`
+	Org       string `json:"org,omitempty"`
+	ProjectID string `json:"projectId"`
+}
+
+// TransformationRules describes how a blueprint run maps GitHub state onto a project's
+// fields for each of the blueprint's connections.
+type TransformationRules struct {
+	// StatusFieldID is the project field updated by LabelToStatus.
+	StatusFieldID string `json:"statusFieldId,omitempty"`
+	// LabelToStatus maps a label name to the single-select option ID it should set on
+	// StatusFieldID when present on an issue or pull request.
+	LabelToStatus map[string]string `json:"labelToStatus,omitempty"`
+	// AutoAddPullRequests adds every pull request in the connection's repository to
+	// ProjectID if it isn't already an item.
+	AutoAddPullRequests bool `json:"autoAddPullRequests,omitempty"`
+	// TypeFieldID is the project field updated by TitlePrefixToType.
+	TypeFieldID string `json:"typeFieldId,omitempty"`
+	// TitlePrefixToType maps a title prefix (e.g. "[bug]") to the single-select option ID
+	// that should be set on TypeFieldID for matching issues.
+	TitlePrefixToType map[string]string `json:"titlePrefixToType,omitempty"`
+}
+
+// Blueprint is a named, scheduled sync configuration: a cron expression, its connections,
+// and one set of transformation rules. The same Rules apply to every connection; rules
+// cannot differ from one connection to another.
+type Blueprint struct {
+	Name        string              `json:"name"`
+	Schedule    string              `json:"schedule"` // standard 5-field cron expression
+	Connections []Connection        `json:"connections"`
+	Rules       TransformationRules `json:"rules"`
+}
+
+// StageError records a single failure encountered while applying a blueprint, without
+// aborting the rest of the run.
+type StageError struct {
+	Stage  string `json:"stage"`
+	Detail string `json:"detail"`
+	ItemID string `json:"itemId,omitempty"`
+}
+
+// ExecutionReport is the structured result of one blueprint run, returned to the MCP
+// caller and persisted to disk so the next run can resume from Cursor.
+type ExecutionReport struct {
+	Blueprint     string       `json:"blueprint"`
+	StartedAt     string       `json:"startedAt"`
+	FinishedAt    string       `json:"finishedAt"`
+	ItemsAdded    int          `json:"itemsAdded"`
+	FieldsUpdated int          `json:"fieldsUpdated"`
+	Errors        []StageError `json:"errors,omitempty"`
+	// Cursor is the latest `updatedAt` timestamp observed across this run's connections,
+	// so the next run can query only items updated since.
+	Cursor string `json:"cursor,omitempty"`
+}
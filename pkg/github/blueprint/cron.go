@@ -0,0 +1,119 @@
+package blueprint
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSchedule is a parsed standard 5-field cron expression (minute hour
+// day-of-month month day-of-week). "*" matches every value; comma-separated lists
+// (e.g. "0,30") are supported; step and range syntax are not.
+type cronSchedule struct {
+	minutes map[int]bool
+	hours   map[int]bool
+	doms    map[int]bool
+	months  map[int]bool
+	dows    map[int]bool
+
+	// domRestricted and dowRestricted record whether the day-of-month/day-of-week fields
+	// were anything other than "*", so matches can apply cron's OR-when-both-restricted
+	// rule instead of ANDing them unconditionally.
+	domRestricted bool
+	dowRestricted bool
+}
+
+// parseCronSchedule parses a standard 5-field cron expression.
+func parseCronSchedule(expr string) (cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return cronSchedule{}, fmt.Errorf("cron expression must have 5 fields, got %d: %q", len(fields), expr)
+	}
+
+	minutes, err := parseCronField(fields[0], 0, 59)
+	if err != nil {
+		return cronSchedule{}, fmt.Errorf("invalid minute field: %w", err)
+	}
+	hours, err := parseCronField(fields[1], 0, 23)
+	if err != nil {
+		return cronSchedule{}, fmt.Errorf("invalid hour field: %w", err)
+	}
+	doms, err := parseCronField(fields[2], 1, 31)
+	if err != nil {
+		return cronSchedule{}, fmt.Errorf("invalid day-of-month field: %w", err)
+	}
+	months, err := parseCronField(fields[3], 1, 12)
+	if err != nil {
+		return cronSchedule{}, fmt.Errorf("invalid month field: %w", err)
+	}
+	dows, err := parseDayOfWeekField(fields[4])
+	if err != nil {
+		return cronSchedule{}, fmt.Errorf("invalid day-of-week field: %w", err)
+	}
+
+	return cronSchedule{
+		minutes:       minutes,
+		hours:         hours,
+		doms:          doms,
+		months:        months,
+		dows:          dows,
+		domRestricted: fields[2] != "*",
+		dowRestricted: fields[4] != "*",
+	}, nil
+}
+
+// parseDayOfWeekField parses the day-of-week field, accepting the standard cron
+// extension where 7 also means Sunday (folded onto 0) in addition to 0-6.
+func parseDayOfWeekField(field string) (map[int]bool, error) {
+	values, err := parseCronField(field, 0, 7)
+	if err != nil {
+		return nil, err
+	}
+	if values[7] {
+		delete(values, 7)
+		values[0] = true
+	}
+	return values, nil
+}
+
+// parseCronField parses one "*" or comma-separated list field into the set of values it
+// matches, bounded by [min, max].
+func parseCronField(field string, min, max int) (map[int]bool, error) {
+	values := map[int]bool{}
+	if field == "*" {
+		for v := min; v <= max; v++ {
+			values[v] = true
+		}
+		return values, nil
+	}
+
+	for _, part := range strings.Split(field, ",") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("not a number: %q", part)
+		}
+		if n < min || n > max {
+			return nil, fmt.Errorf("value %d out of range [%d, %d]", n, min, max)
+		}
+		values[n] = true
+	}
+	return values, nil
+}
+
+// matches reports whether t falls within the schedule, at minute precision. Following
+// standard cron semantics, when both day-of-month and day-of-week are restricted (not
+// "*") a match on either is sufficient; otherwise both must match, which is equivalent
+// since an unrestricted field matches everything.
+func (s cronSchedule) matches(t time.Time) bool {
+	if !s.minutes[t.Minute()] || !s.hours[t.Hour()] || !s.months[int(t.Month())] {
+		return false
+	}
+
+	domMatch := s.doms[t.Day()]
+	dowMatch := s.dows[int(t.Weekday())]
+	if s.domRestricted && s.dowRestricted {
+		return domMatch || dowMatch
+	}
+	return domMatch && dowMatch
+}
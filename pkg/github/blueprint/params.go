@@ -0,0 +1,64 @@
+package blueprint
+
+import (
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// requiredStringParam fetches a required string argument, mirroring the requiredParam
+// helper used throughout pkg/github.
+func requiredStringParam(request mcp.CallToolRequest, name string) (string, error) {
+	args := request.Params.Arguments
+	raw, ok := args[name]
+	if !ok || raw == nil {
+		return "", fmt.Errorf("missing required parameter: %s", name)
+	}
+	s, ok := raw.(string)
+	if !ok || s == "" {
+		return "", fmt.Errorf("parameter %s must be a non-empty string", name)
+	}
+	return s, nil
+}
+
+// optionalStringParam fetches an optional string argument, returning "" when absent.
+func optionalStringParam(request mcp.CallToolRequest, name string) (string, error) {
+	args := request.Params.Arguments
+	raw, ok := args[name]
+	if !ok || raw == nil {
+		return "", nil
+	}
+	s, ok := raw.(string)
+	if !ok {
+		return "", fmt.Errorf("parameter %s must be a string", name)
+	}
+	return s, nil
+}
+
+// optionalBoolParam fetches an optional boolean argument, returning false when absent.
+func optionalBoolParam(request mcp.CallToolRequest, name string) (bool, error) {
+	args := request.Params.Arguments
+	raw, ok := args[name]
+	if !ok || raw == nil {
+		return false, nil
+	}
+	b, ok := raw.(bool)
+	if !ok {
+		return false, fmt.Errorf("parameter %s must be a boolean", name)
+	}
+	return b, nil
+}
+
+// requiredObjectParam fetches a required JSON object argument, decoded as-is.
+func requiredObjectParam(request mcp.CallToolRequest, name string) (map[string]interface{}, error) {
+	args := request.Params.Arguments
+	raw, ok := args[name]
+	if !ok || raw == nil {
+		return nil, fmt.Errorf("missing required parameter: %s", name)
+	}
+	obj, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("parameter %s must be an object", name)
+	}
+	return obj, nil
+}
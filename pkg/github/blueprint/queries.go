@@ -0,0 +1,107 @@
+package blueprint
+
+// searchUpdatedItemsQuery finds issues and pull requests in a repository updated since a
+// cursor timestamp, for a blueprint run to reconcile against its rules. It is kept local
+// to this package rather than the shared githubgql registry because it isn't a Projects V2
+// or label operation used elsewhere in pkg/github.
+const searchUpdatedItemsQuery = `
+query($searchQuery: String!, $first: Int, $after: String) {
+	search(query: $searchQuery, type: ISSUE, first: $first, after: $after) {
+		nodes {
+			__typename
+			... on Issue {
+				id
+				number
+				title
+				state
+				updatedAt
+				labels(first: 20) {
+					nodes {
+						name
+					}
+				}
+			}
+			... on PullRequest {
+				id
+				number
+				title
+				state
+				updatedAt
+				labels(first: 20) {
+					nodes {
+						name
+					}
+				}
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+	rateLimit {
+		cost
+		remaining
+		resetAt
+	}
+}`
+
+type searchLabel struct {
+	Name string `json:"name"`
+}
+
+type searchItem struct {
+	Typename  string `json:"__typename"`
+	ID        string `json:"id"`
+	Number    int    `json:"number"`
+	Title     string `json:"title"`
+	State     string `json:"state"`
+	UpdatedAt string `json:"updatedAt"`
+	Labels    struct {
+		Nodes []searchLabel `json:"nodes"`
+	} `json:"labels"`
+}
+
+// isPullRequest reports whether the search result is a pull request rather than an
+// issue, so AutoAddPullRequests only adds pull requests as its name promises.
+func (s searchItem) isPullRequest() bool {
+	return s.Typename == "PullRequest"
+}
+
+type searchResponse struct {
+	Search struct {
+		Nodes    []searchItem `json:"nodes"`
+		PageInfo struct {
+			HasNextPage bool   `json:"hasNextPage"`
+			EndCursor   string `json:"endCursor"`
+		} `json:"pageInfo"`
+	} `json:"search"`
+}
+
+type projectItemNode struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Content struct {
+		ID string `json:"id"`
+	} `json:"content"`
+}
+
+type listProjectItemsResponse struct {
+	Node struct {
+		Items struct {
+			Nodes    []projectItemNode `json:"nodes"`
+			PageInfo struct {
+				HasNextPage bool   `json:"hasNextPage"`
+				EndCursor   string `json:"endCursor"`
+			} `json:"pageInfo"`
+		} `json:"items"`
+	} `json:"node"`
+}
+
+type addItemToProjectResponse struct {
+	AddProjectV2ItemByID struct {
+		Item struct {
+			ID string `json:"id"`
+		} `json:"item"`
+	} `json:"addProjectV2ItemById"`
+}
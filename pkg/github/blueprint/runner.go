@@ -0,0 +1,250 @@
+package blueprint
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/github/github-mcp-server/pkg/githubgql"
+	gogithub "github.com/google/go-github/v69/github"
+)
+
+const pageSize = 50
+
+// projectItemIndex maps a project item's content node ID to the project item ID, so a
+// blueprint run can tell whether an issue or pull request already has an item.
+type projectItemIndex map[string]string
+
+// loadProjectItemIndex paginates every item on projectID to build the index used to
+// decide whether AddIssueToProject-equivalent work is still needed for an issue or PR.
+func loadProjectItemIndex(ctx context.Context, gql *githubgql.Client, projectID string) (projectItemIndex, error) {
+	index := projectItemIndex{}
+	after := ""
+	for {
+		variables := map[string]interface{}{"projectId": projectID, "first": pageSize}
+		if after != "" {
+			variables["after"] = after
+		}
+
+		var resp listProjectItemsResponse
+		if err := gql.Named(ctx, "listProjectItems", variables, &resp); err != nil {
+			return nil, fmt.Errorf("failed to list project items: %w", err)
+		}
+
+		for _, item := range resp.Node.Items.Nodes {
+			if item.Content.ID != "" {
+				index[item.Content.ID] = item.ID
+			}
+		}
+
+		if !resp.Node.Items.PageInfo.HasNextPage {
+			break
+		}
+		after = resp.Node.Items.PageInfo.EndCursor
+	}
+	return index, nil
+}
+
+// searchUpdatedItems paginates every issue and pull request matching scope (e.g.
+// "repo:owner/name" or "org:name") updated since the cursor timestamp, or every open
+// item in scope if since is empty.
+func searchUpdatedItems(ctx context.Context, gql *githubgql.Client, scope, since string) ([]searchItem, error) {
+	query := scope
+	if since != "" {
+		query = fmt.Sprintf("%s updated:>%s", query, since)
+	} else {
+		query = fmt.Sprintf("%s is:open", query)
+	}
+
+	var items []searchItem
+	after := ""
+	for {
+		variables := map[string]interface{}{"searchQuery": query, "first": pageSize}
+		if after != "" {
+			variables["after"] = after
+		}
+
+		var resp searchResponse
+		if err := gql.Do(ctx, searchUpdatedItemsQuery, variables, &resp); err != nil {
+			return nil, fmt.Errorf("failed to search %q: %w", scope, err)
+		}
+
+		items = append(items, resp.Search.Nodes...)
+
+		if !resp.Search.PageInfo.HasNextPage {
+			break
+		}
+		after = resp.Search.PageInfo.EndCursor
+	}
+	return items, nil
+}
+
+// ensureInProject returns the item ID for contentID on projectID, adding it if it isn't
+// already present, and reports whether it was newly added.
+func ensureInProject(ctx context.Context, gql *githubgql.Client, index projectItemIndex, projectID, contentID string) (itemID string, added bool, err error) {
+	if itemID, ok := index[contentID]; ok {
+		return itemID, false, nil
+	}
+
+	var resp addItemToProjectResponse
+	variables := map[string]interface{}{"projectId": projectID, "contentId": contentID}
+	if err := gql.Named(ctx, "addItemToProject", variables, &resp); err != nil {
+		return "", false, fmt.Errorf("failed to add item to project: %w", err)
+	}
+
+	itemID = resp.AddProjectV2ItemByID.Item.ID
+	index[contentID] = itemID
+	return itemID, true, nil
+}
+
+// setFieldValue applies a single-select option value to itemID's fieldID.
+func setFieldValue(ctx context.Context, gql *githubgql.Client, projectID, itemID, fieldID, optionID string) error {
+	variables := map[string]interface{}{
+		"projectId": projectID,
+		"itemId":    itemID,
+		"fieldId":   fieldID,
+		"value":     map[string]interface{}{"singleSelectOptionId": optionID},
+	}
+	return gql.Named(ctx, "updateProjectItemFieldValue", variables, &struct{}{})
+}
+
+// searchScope builds the search qualifier for conn: "repo:owner/name" when Owner and
+// Repo are set, or "org:name" when Org is set instead.
+func searchScope(conn Connection) (string, error) {
+	switch {
+	case conn.Owner != "" && conn.Repo != "":
+		return fmt.Sprintf("repo:%s/%s", conn.Owner, conn.Repo), nil
+	case conn.Org != "":
+		return fmt.Sprintf("org:%s", conn.Org), nil
+	default:
+		return "", fmt.Errorf("connection must set owner+repo or org")
+	}
+}
+
+// runConnection reconciles one connection's items against its rules, appending to report
+// and advancing cursor to the latest updatedAt observed.
+func runConnection(ctx context.Context, gql *githubgql.Client, conn Connection, rules TransformationRules, since string, report *ExecutionReport) {
+	scope, err := searchScope(conn)
+	if err != nil {
+		report.Errors = append(report.Errors, StageError{Stage: "search", Detail: err.Error()})
+		return
+	}
+
+	index, err := loadProjectItemIndex(ctx, gql, conn.ProjectID)
+	if err != nil {
+		report.Errors = append(report.Errors, StageError{Stage: "list_project_items", Detail: err.Error()})
+		return
+	}
+
+	items, err := searchUpdatedItems(ctx, gql, scope, since)
+	if err != nil {
+		report.Errors = append(report.Errors, StageError{Stage: "search", Detail: err.Error()})
+		return
+	}
+
+	for _, item := range items {
+		if item.UpdatedAt > report.Cursor {
+			report.Cursor = item.UpdatedAt
+		}
+
+		added, fieldsUpdated, err := applyConnectionRules(ctx, gql, conn, rules, index, item)
+		if err != nil {
+			report.Errors = append(report.Errors, StageError{Stage: "apply_rules", Detail: err.Error(), ItemID: item.ID})
+			continue
+		}
+		if added {
+			report.ItemsAdded++
+		}
+		report.FieldsUpdated += fieldsUpdated
+	}
+}
+
+// applyConnectionRules adds item to the project if AutoAddPullRequests or a matching
+// rule requires it, then applies any matching label-to-status or title-prefix-to-type
+// field updates. It returns whether the item was newly added to the project and how
+// many fields were updated.
+func applyConnectionRules(ctx context.Context, gql *githubgql.Client, conn Connection, rules TransformationRules, index projectItemIndex, item searchItem) (added bool, fieldsUpdated int, err error) {
+	statusOption, hasStatus := matchLabelToStatus(rules, item)
+	typeOption, hasType := matchTitlePrefixToType(rules, item)
+
+	needsItem := (rules.AutoAddPullRequests && item.isPullRequest()) || hasStatus || hasType
+	if !needsItem {
+		return false, 0, nil
+	}
+
+	itemID, added, err := ensureInProject(ctx, gql, index, conn.ProjectID, item.ID)
+	if err != nil {
+		return false, 0, err
+	}
+
+	if hasStatus {
+		if err := setFieldValue(ctx, gql, conn.ProjectID, itemID, rules.StatusFieldID, statusOption); err != nil {
+			return added, fieldsUpdated, fmt.Errorf("failed to set status field: %w", err)
+		}
+		fieldsUpdated++
+	}
+	if hasType {
+		if err := setFieldValue(ctx, gql, conn.ProjectID, itemID, rules.TypeFieldID, typeOption); err != nil {
+			return added, fieldsUpdated, fmt.Errorf("failed to set type field: %w", err)
+		}
+		fieldsUpdated++
+	}
+
+	return added, fieldsUpdated, nil
+}
+
+func matchLabelToStatus(rules TransformationRules, item searchItem) (optionID string, ok bool) {
+	if rules.StatusFieldID == "" {
+		return "", false
+	}
+	for _, label := range item.Labels.Nodes {
+		if option, ok := rules.LabelToStatus[label.Name]; ok {
+			return option, true
+		}
+	}
+	return "", false
+}
+
+// matchTitlePrefixToType returns the option for the longest configured prefix matching
+// item's title, breaking ties on the prefix text itself so the result is deterministic
+// regardless of Go's randomized map iteration order.
+func matchTitlePrefixToType(rules TransformationRules, item searchItem) (optionID string, ok bool) {
+	if rules.TypeFieldID == "" {
+		return "", false
+	}
+
+	bestPrefix := ""
+	for prefix, option := range rules.TitlePrefixToType {
+		if !strings.HasPrefix(item.Title, prefix) {
+			continue
+		}
+		if len(prefix) > len(bestPrefix) || (len(prefix) == len(bestPrefix) && prefix < bestPrefix) {
+			bestPrefix = prefix
+			optionID = option
+			ok = true
+		}
+	}
+	return optionID, ok
+}
+
+// Run reconciles every connection in bp against its transformation rules, producing a
+// structured execution report. since is the updatedAt cursor to resume from; when it is
+// empty, every open item in each connection is scanned. Run does not read the persisted
+// report itself: callers pass in the cursor they want it to start from.
+func Run(ctx context.Context, client *gogithub.Client, bp Blueprint, since string) ExecutionReport {
+	report := ExecutionReport{
+		Blueprint: bp.Name,
+		StartedAt: time.Now().UTC().Format(time.RFC3339),
+		Cursor:    since,
+	}
+
+	gql := github.NewGraphQLClient(client)
+	for _, conn := range bp.Connections {
+		runConnection(ctx, gql, conn, bp.Rules, since, &report)
+	}
+
+	report.FinishedAt = time.Now().UTC().Format(time.RFC3339)
+	return report
+}
@@ -0,0 +1,87 @@
+package blueprint
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/github"
+)
+
+// tickInterval is how often the scheduler checks blueprint schedules against the clock.
+// Cron expressions are evaluated at minute precision, so this matches a standard minute.
+const tickInterval = time.Minute
+
+// Scheduler periodically re-runs every blueprint in the store whose cron schedule
+// matches the current time, persisting an ExecutionReport after each run.
+type Scheduler struct {
+	getClient github.GetClientFn
+}
+
+// NewScheduler builds a Scheduler that resolves a GitHub client via getClient for each
+// run.
+func NewScheduler(getClient github.GetClientFn) *Scheduler {
+	return &Scheduler{getClient: getClient}
+}
+
+// Start runs the scheduler loop until ctx is canceled. It checks every tickInterval for
+// blueprints whose schedule matches the current minute and runs them in the background,
+// so one slow blueprint doesn't delay the rest.
+func (s *Scheduler) Start(ctx context.Context) {
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.runDue(ctx, now)
+		}
+	}
+}
+
+func (s *Scheduler) runDue(ctx context.Context, now time.Time) {
+	blueprints, err := loadBlueprints(blueprintStorePath())
+	if err != nil {
+		log.Printf("blueprint scheduler: failed to load blueprints: %v", err)
+		return
+	}
+
+	for _, bp := range blueprints {
+		schedule, err := parseCronSchedule(bp.Schedule)
+		if err != nil {
+			log.Printf("blueprint scheduler: skipping %q: %v", bp.Name, err)
+			continue
+		}
+		if !schedule.matches(now) {
+			continue
+		}
+
+		go s.runOnce(ctx, bp)
+	}
+}
+
+func (s *Scheduler) runOnce(ctx context.Context, bp Blueprint) {
+	client, err := s.getClient(ctx)
+	if err != nil {
+		log.Printf("blueprint scheduler: failed to get GitHub client for %q: %v", bp.Name, err)
+		return
+	}
+
+	prior, err := loadLastReport(reportStorePath(), bp.Name)
+	if err != nil {
+		log.Printf("blueprint scheduler: failed to load last report for %q: %v", bp.Name, err)
+		return
+	}
+
+	since := ""
+	if prior != nil {
+		since = prior.Cursor
+	}
+
+	report := Run(ctx, client, bp, since)
+	if err := saveReport(reportStorePath(), report); err != nil {
+		log.Printf("blueprint scheduler: failed to save report for %q: %v", bp.Name, err)
+	}
+}
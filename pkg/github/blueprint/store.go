@@ -0,0 +1,154 @@
+package blueprint
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultBlueprintStorePath = "blueprints.json"
+	defaultReportStorePath    = "blueprint_reports.json"
+)
+
+// reportStoreMu guards saveReport's read-modify-write of the report store file, so
+// concurrently finishing blueprint runs (the scheduler starts one goroutine per due
+// blueprint) don't clobber each other's freshly-saved reports.
+var reportStoreMu sync.Mutex
+
+func blueprintStorePath() string {
+	if path := viper.GetString("blueprint.store_path"); path != "" {
+		return path
+	}
+	return defaultBlueprintStorePath
+}
+
+func reportStorePath() string {
+	if path := viper.GetString("blueprint.report_store_path"); path != "" {
+		return path
+	}
+	return defaultReportStorePath
+}
+
+// loadBlueprints reads every blueprint persisted at path, returning an empty slice if the
+// file doesn't exist yet.
+func loadBlueprints(path string) ([]Blueprint, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return []Blueprint{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read blueprint store: %w", err)
+	}
+
+	var blueprints []Blueprint
+	if err := json.Unmarshal(data, &blueprints); err != nil {
+		return nil, fmt.Errorf("failed to decode blueprint store: %w", err)
+	}
+	return blueprints, nil
+}
+
+// saveBlueprints overwrites path with blueprints.
+func saveBlueprints(path string, blueprints []Blueprint) error {
+	data, err := json.MarshalIndent(blueprints, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode blueprint store: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write blueprint store: %w", err)
+	}
+	return nil
+}
+
+// upsertBlueprint saves bp into the blueprint store at path, replacing any existing
+// blueprint with the same name.
+func upsertBlueprint(path string, bp Blueprint) error {
+	blueprints, err := loadBlueprints(path)
+	if err != nil {
+		return err
+	}
+
+	replaced := false
+	for i, existing := range blueprints {
+		if existing.Name == bp.Name {
+			blueprints[i] = bp
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		blueprints = append(blueprints, bp)
+	}
+
+	return saveBlueprints(path, blueprints)
+}
+
+// findBlueprint returns the blueprint named name from the store at path.
+func findBlueprint(path, name string) (Blueprint, error) {
+	blueprints, err := loadBlueprints(path)
+	if err != nil {
+		return Blueprint{}, err
+	}
+	for _, bp := range blueprints {
+		if bp.Name == name {
+			return bp, nil
+		}
+	}
+	return Blueprint{}, fmt.Errorf("no blueprint named %q", name)
+}
+
+// loadLastReport returns the most recent execution report for name, so a run can resume
+// from its Cursor, or nil if the blueprint has never run.
+func loadLastReport(path, name string) (*ExecutionReport, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read blueprint report store: %w", err)
+	}
+
+	var reports map[string]ExecutionReport
+	if err := json.Unmarshal(data, &reports); err != nil {
+		return nil, fmt.Errorf("failed to decode blueprint report store: %w", err)
+	}
+
+	report, ok := reports[name]
+	if !ok {
+		return nil, nil
+	}
+	return &report, nil
+}
+
+// saveReport persists report as the latest run for its blueprint, keyed by name.
+func saveReport(path string, report ExecutionReport) error {
+	reportStoreMu.Lock()
+	defer reportStoreMu.Unlock()
+
+	var reports map[string]ExecutionReport
+	data, err := os.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		reports = map[string]ExecutionReport{}
+	case err != nil:
+		return fmt.Errorf("failed to read blueprint report store: %w", err)
+	default:
+		if err := json.Unmarshal(data, &reports); err != nil {
+			return fmt.Errorf("failed to decode blueprint report store: %w", err)
+		}
+	}
+
+	reports[report.Blueprint] = report
+
+	out, err := json.MarshalIndent(reports, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode blueprint report store: %w", err)
+	}
+	if err := os.WriteFile(path, out, 0o644); err != nil {
+		return fmt.Errorf("failed to write blueprint report store: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package blueprint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// CreateBlueprint creates a tool that persists a blueprint (its connections and
+// transformation rules) for later execution by run_blueprint or the background
+// scheduler.
+func CreateBlueprint(t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_blueprint",
+			mcp.WithDescription(t("TOOL_CREATE_BLUEPRINT_DESCRIPTION", "Create or update a blueprint: a scheduled sync configuration mapping labels and title prefixes onto Projects V2 fields for one or more repositories.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_BLUEPRINT_USER_TITLE", "Create blueprint"),
+				ReadOnlyHint: false,
+			}),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Unique name for this blueprint"),
+			),
+			mcp.WithString("schedule",
+				mcp.Required(),
+				mcp.Description("Standard 5-field cron expression (minute hour day-of-month month day-of-week) the scheduler re-runs this blueprint on"),
+			),
+			mcp.WithArray("connections",
+				mcp.Required(),
+				mcp.Description("Connections this blueprint applies to, each an object with \"owner\", \"repo\", and \"projectId\""),
+			),
+			mcp.WithObject("rules",
+				mcp.Required(),
+				mcp.Description("Transformation rules: statusFieldId, labelToStatus, autoAddPullRequests, typeFieldId, titlePrefixToType"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			name, err := requiredStringParam(request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			schedule, err := requiredStringParam(request, "schedule")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if _, err := parseCronSchedule(schedule); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid schedule: %s", err.Error())), nil
+			}
+
+			connectionsRaw, err := requiredArrayParam(request, "connections")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			var connections []Connection
+			if err := remarshal(connectionsRaw, &connections); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid connections: %s", err.Error())), nil
+			}
+
+			rulesRaw, err := requiredObjectParam(request, "rules")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			var rules TransformationRules
+			if err := remarshal(rulesRaw, &rules); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid rules: %s", err.Error())), nil
+			}
+
+			bp := Blueprint{Name: name, Schedule: schedule, Connections: connections, Rules: rules}
+			if err := upsertBlueprint(blueprintStorePath(), bp); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			r, err := json.Marshal(bp)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// RunBlueprint creates a tool that runs a stored blueprint immediately, reconciling its
+// connections against its transformation rules and returning the structured execution
+// report.
+func RunBlueprint(getClient github.GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("run_blueprint",
+			mcp.WithDescription(t("TOOL_RUN_BLUEPRINT_DESCRIPTION", "Run a stored blueprint immediately, applying its transformation rules to every connection and returning a structured execution report.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_RUN_BLUEPRINT_USER_TITLE", "Run blueprint"),
+				ReadOnlyHint: false,
+			}),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Name of the blueprint to run"),
+			),
+			mcp.WithString("since",
+				mcp.Description("Override the blueprint's last checkpointed updatedAt cursor; omit to resume from the last run"),
+			),
+			mcp.WithBoolean("full_resync",
+				mcp.Description("Ignore the last checkpointed cursor and scan every open item in each connection from scratch"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			name, err := requiredStringParam(request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			since, err := optionalStringParam(request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			fullResync, err := optionalBoolParam(request, "full_resync")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			bp, err := findBlueprint(blueprintStorePath(), name)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if since == "" && !fullResync {
+				prior, err := loadLastReport(reportStorePath(), name)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if prior != nil {
+					since = prior.Cursor
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			report := Run(ctx, client, bp, since)
+			if err := saveReport(reportStorePath(), report); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			r, err := json.Marshal(report)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListBlueprints creates a tool that lists every stored blueprint.
+func ListBlueprints(t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_blueprints",
+			mcp.WithDescription(t("TOOL_LIST_BLUEPRINTS_DESCRIPTION", "List every stored blueprint and its schedule.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_BLUEPRINTS_USER_TITLE", "List blueprints"),
+				ReadOnlyHint: true,
+			}),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			blueprints, err := loadBlueprints(blueprintStorePath())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			r, err := json.Marshal(blueprints)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// requiredArrayParam fetches a required array argument, decoded as-is.
+func requiredArrayParam(request mcp.CallToolRequest, name string) ([]interface{}, error) {
+	args := request.Params.Arguments
+	raw, ok := args[name]
+	if !ok || raw == nil {
+		return nil, fmt.Errorf("missing required parameter: %s", name)
+	}
+	arr, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("parameter %s must be an array", name)
+	}
+	return arr, nil
+}
+
+// remarshal round-trips v through JSON into out, the simplest way to turn the loosely
+// typed map[string]interface{}/[]interface{} MCP arguments into a typed struct.
+func remarshal(v interface{}, out interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
@@ -0,0 +1,285 @@
+// Package export streams large GitHub result sets (issues, pull requests, and Projects V2
+// items) to a caller-supplied sink as newline-delimited JSON, checkpointing progress so an
+// interrupted export can resume instead of starting over.
+package export
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/github/github-mcp-server/pkg/translations"
+	gogithub "github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ExportResult is emitted on the internal export channel for each page fetched, letting
+// the MCP handler report incremental progress as the export runs.
+type ExportResult struct {
+	Resource      string
+	Cursor        string
+	ItemsExported int
+	Err           error
+}
+
+const defaultPageSize = 50
+
+// filterFields projects a decoded node down to the caller-requested field set, always
+// keeping "id" so checkpointing and resumption have a stable key to report.
+func filterFields(node map[string]interface{}, fields []string) map[string]interface{} {
+	filtered := map[string]interface{}{"id": node["id"]}
+	for _, field := range fields {
+		if value, ok := node[field]; ok {
+			filtered[field] = value
+		}
+	}
+	return filtered
+}
+
+// fetchPage runs the GraphQL query for one resource kind and returns the decoded page of
+// nodes regardless of which envelope shape that resource uses.
+func fetchPage(ctx context.Context, client *gogithub.Client, resource, owner, repo, projectID, after string, pageSize int) (nodePage, error) {
+	gql := github.NewGraphQLClient(client)
+
+	switch resource {
+	case "issues":
+		variables := map[string]interface{}{"owner": owner, "repo": repo, "first": pageSize, "after": nilIfEmpty(after)}
+		var resp issuesResponse
+		if err := gql.Do(ctx, issuesQuery, variables, &resp); err != nil {
+			return nodePage{}, err
+		}
+		return resp.Repository.Issues, nil
+	case "pull_requests":
+		variables := map[string]interface{}{"owner": owner, "repo": repo, "first": pageSize, "after": nilIfEmpty(after)}
+		var resp pullRequestsResponse
+		if err := gql.Do(ctx, pullRequestsQuery, variables, &resp); err != nil {
+			return nodePage{}, err
+		}
+		return resp.Repository.PullRequests, nil
+	case "project_items":
+		variables := map[string]interface{}{"projectId": projectID, "first": pageSize, "after": nilIfEmpty(after)}
+		var resp projectItemsResponse
+		if err := gql.Do(ctx, projectItemsQuery, variables, &resp); err != nil {
+			return nodePage{}, err
+		}
+		return resp.Node.Items, nil
+	default:
+		return nodePage{}, fmt.Errorf("unsupported resource: %s", resource)
+	}
+}
+
+func nilIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// runExport walks every page of the requested resource, writing each filtered node as one
+// NDJSON line to sink and checkpointing after every page, and reports progress on results.
+// startCount seeds the cumulative exported-row counter, so a resumed run's checkpoint and
+// progress reports reflect the total across all runs rather than just this one.
+func runExport(ctx context.Context, client *gogithub.Client, resource, owner, repo, projectID, sink string, fields []string, startCursor string, startCount, pageSize int, results chan<- ExportResult) {
+	defer close(results)
+
+	mode := os.O_CREATE | os.O_WRONLY
+	if startCursor != "" {
+		mode |= os.O_APPEND
+	} else {
+		mode |= os.O_TRUNC
+	}
+
+	file, err := os.OpenFile(sink, mode, 0o644)
+	if err != nil {
+		results <- ExportResult{Resource: resource, Err: fmt.Errorf("failed to open sink: %w", err)}
+		return
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	defer writer.Flush()
+
+	cursor := startCursor
+	total := startCount
+	for {
+		page, err := fetchPage(ctx, client, resource, owner, repo, projectID, cursor, pageSize)
+		if err != nil {
+			results <- ExportResult{Resource: resource, Cursor: cursor, Err: err}
+			return
+		}
+
+		lastExportedID := ""
+		for _, node := range page.Nodes {
+			line, err := json.Marshal(filterFields(node, fields))
+			if err != nil {
+				results <- ExportResult{Resource: resource, Cursor: cursor, Err: fmt.Errorf("failed to encode node: %w", err)}
+				return
+			}
+			if _, err := writer.Write(append(line, '\n')); err != nil {
+				results <- ExportResult{Resource: resource, Cursor: cursor, Err: fmt.Errorf("failed to write sink: %w", err)}
+				return
+			}
+			total++
+			if id, ok := node["id"].(string); ok {
+				lastExportedID = id
+			}
+		}
+
+		if err := writer.Flush(); err != nil {
+			results <- ExportResult{Resource: resource, Cursor: cursor, Err: fmt.Errorf("failed to flush sink: %w", err)}
+			return
+		}
+
+		cursor = page.PageInfo.EndCursor
+		cp := checkpoint{Resource: resource, Cursor: cursor, LastExportedID: lastExportedID, ExportedCount: total}
+		if err := saveCheckpoint(sink, cp); err != nil {
+			results <- ExportResult{Resource: resource, Cursor: cursor, Err: err}
+			return
+		}
+
+		results <- ExportResult{Resource: resource, Cursor: cursor, ItemsExported: total}
+
+		if !page.PageInfo.HasNextPage {
+			return
+		}
+	}
+}
+
+// BulkExport creates a tool that streams issues, pull requests, or Projects V2 items to a
+// file as newline-delimited JSON, resuming from a prior checkpoint when one exists.
+func BulkExport(getClient github.GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("bulk_export",
+			mcp.WithDescription(t("TOOL_BULK_EXPORT_DESCRIPTION", "Stream issues, pull requests, or Projects V2 items to a file as newline-delimited JSON, resuming automatically from the last checkpoint.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_BULK_EXPORT_USER_TITLE", "Bulk export"),
+				ReadOnlyHint: true,
+			}),
+			mcp.WithString("resource",
+				mcp.Required(),
+				mcp.Description("Resource type to export"),
+				mcp.Enum("issues", "pull_requests", "project_items"),
+			),
+			mcp.WithString("owner",
+				mcp.Description("Repository owner (required for issues and pull_requests)"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Repository name (required for issues and pull_requests)"),
+			),
+			mcp.WithString("project_id",
+				mcp.Description("Project ID, GraphQL node ID (required for project_items)"),
+			),
+			mcp.WithArray("fields",
+				mcp.Required(),
+				mcp.Description("Field names to include for each exported node, e.g. [\"number\",\"title\",\"labels\",\"projectItems\"]"),
+			),
+			mcp.WithString("sink",
+				mcp.Required(),
+				mcp.Description("File path to stream newline-delimited JSON results to"),
+			),
+			mcp.WithString("since",
+				mcp.Description("Cursor to resume from; defaults to the sink's last checkpoint if omitted"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			resource, err := requiredStringParam(request, "resource")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			owner, err := optionalStringParam(request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			repo, err := optionalStringParam(request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			projectID, err := optionalStringParam(request, "project_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			fields, err := requiredStringSliceParam(request, "fields")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			sink, err := requiredStringParam(request, "sink")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			since, err := optionalStringParam(request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if (resource == "issues" || resource == "pull_requests") && (owner == "" || repo == "") {
+				return mcp.NewToolResultError(fmt.Sprintf("owner and repo are required when resource is %s", resource)), nil
+			}
+			if resource == "project_items" && projectID == "" {
+				return mcp.NewToolResultError("project_id is required when resource is project_items"), nil
+			}
+
+			prior, err := loadCheckpoint(sink)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			startCursor := since
+			if startCursor == "" && prior != nil && prior.Resource == resource {
+				startCursor = prior.Cursor
+			}
+
+			startCount := 0
+			if startCursor != "" && prior != nil && prior.Resource == resource {
+				startCount = prior.ExportedCount
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			results := make(chan ExportResult)
+			go runExport(ctx, client, resource, owner, repo, projectID, sink, fields, startCursor, startCount, defaultPageSize, results)
+
+			var (
+				exported   int
+				lastCursor string
+				exportErr  error
+			)
+			for result := range results {
+				if result.Err != nil {
+					exportErr = result.Err
+					break
+				}
+				exported = result.ItemsExported
+				lastCursor = result.Cursor
+			}
+
+			summary := map[string]interface{}{
+				"resource": resource,
+				"sink":     sink,
+				"exported": exported,
+				"cursor":   lastCursor,
+			}
+			if exportErr != nil {
+				summary["error"] = exportErr.Error()
+				summary["resumable"] = true
+			}
+
+			r, err := json.Marshal(summary)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
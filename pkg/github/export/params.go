@@ -0,0 +1,58 @@
+package export
+
+import (
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// requiredStringParam fetches a required string argument, mirroring the requiredParam
+// helper used throughout pkg/github.
+func requiredStringParam(request mcp.CallToolRequest, name string) (string, error) {
+	args := request.Params.Arguments
+	raw, ok := args[name]
+	if !ok || raw == nil {
+		return "", fmt.Errorf("missing required parameter: %s", name)
+	}
+	s, ok := raw.(string)
+	if !ok || s == "" {
+		return "", fmt.Errorf("parameter %s must be a non-empty string", name)
+	}
+	return s, nil
+}
+
+// optionalStringParam fetches an optional string argument, returning "" when absent.
+func optionalStringParam(request mcp.CallToolRequest, name string) (string, error) {
+	args := request.Params.Arguments
+	raw, ok := args[name]
+	if !ok || raw == nil {
+		return "", nil
+	}
+	s, ok := raw.(string)
+	if !ok {
+		return "", fmt.Errorf("parameter %s must be a string", name)
+	}
+	return s, nil
+}
+
+// requiredStringSliceParam fetches a required array-of-string argument.
+func requiredStringSliceParam(request mcp.CallToolRequest, name string) ([]string, error) {
+	args := request.Params.Arguments
+	raw, ok := args[name]
+	if !ok || raw == nil {
+		return nil, fmt.Errorf("missing required parameter: %s", name)
+	}
+	items, ok := raw.([]interface{})
+	if !ok || len(items) == 0 {
+		return nil, fmt.Errorf("parameter %s must be a non-empty array", name)
+	}
+	fields := make([]string, 0, len(items))
+	for _, item := range items {
+		s, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("parameter %s must be an array of strings", name)
+		}
+		fields = append(fields, s)
+	}
+	return fields, nil
+}
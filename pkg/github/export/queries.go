@@ -0,0 +1,136 @@
+package export
+
+// GraphQL queries for the bulk exporter. Each query fetches a superset of fields; the
+// caller-supplied `fields` selection is applied client-side when each node is written out.
+const (
+	issuesQuery = `
+	query($owner: String!, $repo: String!, $first: Int!, $after: String) {
+		repository(owner: $owner, name: $repo) {
+			issues(first: $first, after: $after) {
+				nodes {
+					id
+					number
+					title
+					state
+					url
+					labels(first: 20) {
+						nodes {
+							name
+						}
+					}
+					projectItems(first: 10) {
+						nodes {
+							id
+							project {
+								id
+								title
+							}
+						}
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	}`
+
+	pullRequestsQuery = `
+	query($owner: String!, $repo: String!, $first: Int!, $after: String) {
+		repository(owner: $owner, name: $repo) {
+			pullRequests(first: $first, after: $after) {
+				nodes {
+					id
+					number
+					title
+					state
+					url
+					labels(first: 20) {
+						nodes {
+							name
+						}
+					}
+					projectItems(first: 10) {
+						nodes {
+							id
+							project {
+								id
+								title
+							}
+						}
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	}`
+
+	projectItemsQuery = `
+	query($projectId: ID!, $first: Int!, $after: String) {
+		node(id: $projectId) {
+			... on ProjectV2 {
+				items(first: $first, after: $after) {
+					nodes {
+						id
+						type
+						content {
+							... on Issue {
+								id
+								number
+								title
+								url
+							}
+							... on PullRequest {
+								id
+								number
+								title
+								url
+							}
+							... on DraftIssue {
+								title
+							}
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	}`
+)
+
+// nodePage is the common shape of a paginated nodes{...} GraphQL selection, decoded
+// generically so each node's fields can be filtered to the caller's selection.
+type nodePage struct {
+	Nodes    []map[string]interface{} `json:"nodes"`
+	PageInfo struct {
+		HasNextPage bool   `json:"hasNextPage"`
+		EndCursor   string `json:"endCursor"`
+	} `json:"pageInfo"`
+}
+
+// issuesResponse/pullRequestsResponse/projectItemsResponse wrap nodePage in the envelope
+// shape returned for each resource kind.
+type issuesResponse struct {
+	Repository struct {
+		Issues nodePage `json:"issues"`
+	} `json:"repository"`
+}
+
+type pullRequestsResponse struct {
+	Repository struct {
+		PullRequests nodePage `json:"pullRequests"`
+	} `json:"repository"`
+}
+
+type projectItemsResponse struct {
+	Node struct {
+		Items nodePage `json:"items"`
+	} `json:"node"`
+}
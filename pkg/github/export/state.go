@@ -0,0 +1,52 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// checkpoint records how far a bulk_export run has progressed for a given sink, so an
+// interrupted export can resume from its cursor (automatically, or via the bulk_export
+// `since` parameter) instead of starting over.
+type checkpoint struct {
+	Resource       string `json:"resource"`
+	Cursor         string `json:"cursor"`
+	LastExportedID string `json:"lastExportedId"`
+	ExportedCount  int    `json:"exportedCount"`
+}
+
+// checkpointPath derives the checkpoint file path from the sink path it accompanies.
+func checkpointPath(sink string) string {
+	return sink + ".checkpoint.json"
+}
+
+// loadCheckpoint reads a prior run's checkpoint, if one exists alongside the sink.
+func loadCheckpoint(sink string) (*checkpoint, error) {
+	data, err := os.ReadFile(checkpointPath(sink))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+
+	var cp checkpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, fmt.Errorf("failed to decode checkpoint: %w", err)
+	}
+	return &cp, nil
+}
+
+// saveCheckpoint persists the current cursor and last exported node ID so a later run
+// can resume from this point.
+func saveCheckpoint(sink string, cp checkpoint) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return fmt.Errorf("failed to encode checkpoint: %w", err)
+	}
+	if err := os.WriteFile(checkpointPath(sink), data, 0o644); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	return nil
+}
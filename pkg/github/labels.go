@@ -0,0 +1,357 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/spf13/viper"
+)
+
+// scopedLabelMarker is the leading marker recorded in a label's description to mark it
+// as an exclusive scoped label when the name alone doesn't match a configured prefix.
+const scopedLabelMarker = "[scoped]"
+
+// defaultExclusiveLabelPrefixes are the scope/ prefixes treated as exclusive out of the
+// box; a label matching one of these patterns has any sibling label sharing the same
+// prefix removed whenever it is applied. Override via the `scoped_labels.exclusive_prefixes`
+// config key (same viper pattern as `personal_access_token`).
+var defaultExclusiveLabelPrefixes = []string{"^priority/", "^status/"}
+
+// ScopedLabel represents a scope/value label and the result of applying it
+type ScopedLabel struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Exclusive bool     `json:"exclusive"`
+	Removed   []string `json:"removed,omitempty"`
+}
+
+// exclusiveLabelPrefixes returns the configured regexes for scope prefixes that are
+// always treated as exclusive, falling back to defaultExclusiveLabelPrefixes.
+func exclusiveLabelPrefixes() []string {
+	if prefixes := viper.GetStringSlice("scoped_labels.exclusive_prefixes"); len(prefixes) > 0 {
+		return prefixes
+	}
+	return defaultExclusiveLabelPrefixes
+}
+
+// isExclusiveScopedLabel reports whether a label should enforce scope exclusivity,
+// either because its description carries the scopedLabelMarker or its name matches one
+// of the configured exclusive prefixes.
+func isExclusiveScopedLabel(name, description string) bool {
+	if strings.HasPrefix(strings.TrimSpace(description), scopedLabelMarker) {
+		return true
+	}
+	for _, pattern := range exclusiveLabelPrefixes() {
+		if matched, _ := regexp.MatchString(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// labelScopePrefix splits a scoped label name (e.g. "priority/high") on its last "/" and
+// returns the prefix including the slash (e.g. "priority/"). ok is false for unscoped names.
+func labelScopePrefix(name string) (prefix string, ok bool) {
+	idx := strings.LastIndex(name, "/")
+	if idx <= 0 {
+		return "", false
+	}
+	return name[:idx+1], true
+}
+
+// SetScopedLabel creates a tool that applies a scope/value label to an issue or pull
+// request, automatically removing any other label sharing the same scope/ prefix when
+// the label is exclusive. This is the only path in this package that enforces scope
+// exclusivity: no other tool here mutates issue/pull request labels, so applying a
+// scoped label through any other means (e.g. the GitHub UI, or a future label-add tool)
+// bypasses the exclusivity check.
+func SetScopedLabel(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("set_scoped_label",
+			mcp.WithDescription(t("TOOL_SET_SCOPED_LABEL_DESCRIPTION", "Apply a scoped label (e.g. \"priority/high\") to an issue or pull request, removing any other label that shares the same scope/ prefix when the label is exclusive. Scope exclusivity is only enforced through this tool.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SET_SCOPED_LABEL_USER_TITLE", "Set scoped label"),
+				ReadOnlyHint: false,
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue or pull request number"),
+			),
+			mcp.WithString("label",
+				mcp.Required(),
+				mcp.Description("Scoped label name to apply, e.g. \"priority/high\""),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			label, err := requiredParam[string](request, "label")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			labelableID, existingLabels, err := getIssuableLabels(ctx, client, owner, repo, issueNumber)
+			if err != nil {
+				return nil, err
+			}
+
+			targetLabel, err := getRepositoryLabel(ctx, client, owner, repo, label)
+			if err != nil {
+				return nil, err
+			}
+
+			result := ScopedLabel{ID: targetLabel.ID, Name: targetLabel.Name}
+
+			removeIDs := []string{}
+			if prefix, ok := labelScopePrefix(targetLabel.Name); ok && isExclusiveScopedLabel(targetLabel.Name, targetLabel.Description) {
+				result.Exclusive = true
+				for _, existing := range existingLabels {
+					if existing.ID == targetLabel.ID {
+						continue
+					}
+					if existingPrefix, ok := labelScopePrefix(existing.Name); ok && existingPrefix == prefix {
+						removeIDs = append(removeIDs, existing.ID)
+						result.Removed = append(result.Removed, existing.Name)
+					}
+				}
+			}
+
+			variables := map[string]interface{}{
+				"labelableId":    labelableID,
+				"addLabelIds":    []string{targetLabel.ID},
+				"removeLabelIds": removeIDs,
+			}
+
+			var response struct {
+				AddLabelsToLabelable struct {
+					ClientMutationID string `json:"clientMutationId"`
+				} `json:"addLabelsToLabelable"`
+				RemoveLabelsFromLabelable struct {
+					ClientMutationID string `json:"clientMutationId"`
+				} `json:"removeLabelsFromLabelable"`
+			}
+
+			if err := graphQLClientFor(client).Named(ctx, "setScopedLabel", variables, &response); err != nil {
+				return nil, fmt.Errorf("failed to set scoped label: %w", err)
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateScopedLabel creates a tool that creates a repository label and, when requested,
+// records it as an exclusive scoped label via the scopedLabelMarker description prefix.
+func CreateScopedLabel(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_scoped_label",
+			mcp.WithDescription(t("TOOL_CREATE_SCOPED_LABEL_DESCRIPTION", "Create a repository label, optionally marking it exclusive so applying it removes sibling labels sharing its scope/ prefix.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_SCOPED_LABEL_USER_TITLE", "Create scoped label"),
+				ReadOnlyHint: false,
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Scoped label name to create, e.g. \"priority/high\""),
+			),
+			mcp.WithString("color",
+				mcp.Required(),
+				mcp.Description("Label color as a 6-character hex code, without the leading #"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Label description"),
+			),
+			mcp.WithBoolean("exclusive",
+				mcp.Description("Whether applying this label should remove sibling labels sharing its scope/ prefix. A name matching a configured exclusive prefix (by default priority/ and status/) is exclusive even when this is false"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			name, err := requiredParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, ok := labelScopePrefix(name); !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("label name %q is not scoped; expected the form \"scope/value\"", name)), nil
+			}
+
+			color, err := requiredParam[string](request, "color")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			description, err := OptionalParam[string](request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			exclusive, err := OptionalParam[bool](request, "exclusive")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if exclusive {
+				description = strings.TrimSpace(scopedLabelMarker + " " + description)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			repository, _, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get repository: %w", err)
+			}
+
+			if repository.NodeID == nil {
+				return nil, fmt.Errorf("repository node ID is nil")
+			}
+
+			variables := map[string]interface{}{
+				"repositoryId": *repository.NodeID,
+				"name":         name,
+				"color":        color,
+				"description":  description,
+			}
+
+			var response struct {
+				CreateLabel struct {
+					Label struct {
+						ID          string `json:"id"`
+						Name        string `json:"name"`
+						Description string `json:"description"`
+					} `json:"label"`
+				} `json:"createLabel"`
+			}
+
+			if err := graphQLClientFor(client).Named(ctx, "createLabel", variables, &response); err != nil {
+				return nil, fmt.Errorf("failed to create scoped label: %w", err)
+			}
+
+			result := ScopedLabel{
+				ID:        response.CreateLabel.Label.ID,
+				Name:      response.CreateLabel.Label.Name,
+				Exclusive: isExclusiveScopedLabel(response.CreateLabel.Label.Name, response.CreateLabel.Label.Description),
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// labelNode is a label as returned by the labels GraphQL selection set
+type labelNode struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// getIssuableLabels fetches the GraphQL node ID and current labels of an issue or pull
+// request identified by its repo-scoped number.
+func getIssuableLabels(ctx context.Context, client *github.Client, owner, repo string, number int) (labelableID string, labels []labelNode, err error) {
+	variables := map[string]interface{}{
+		"owner":  owner,
+		"repo":   repo,
+		"number": number,
+	}
+
+	var response struct {
+		Repository struct {
+			IssueOrPullRequest struct {
+				ID     string `json:"id"`
+				Labels struct {
+					Nodes []labelNode `json:"nodes"`
+				} `json:"labels"`
+			} `json:"issueOrPullRequest"`
+		} `json:"repository"`
+	}
+
+	if err := graphQLClientFor(client).Named(ctx, "issuableLabels", variables, &response); err != nil {
+		return "", nil, fmt.Errorf("failed to get issue or pull request labels: %w", err)
+	}
+
+	return response.Repository.IssueOrPullRequest.ID, response.Repository.IssueOrPullRequest.Labels.Nodes, nil
+}
+
+// getRepositoryLabel resolves a label name to its GraphQL node ID and description.
+func getRepositoryLabel(ctx context.Context, client *github.Client, owner, repo, name string) (labelNode, error) {
+	variables := map[string]interface{}{
+		"owner": owner,
+		"repo":  repo,
+		"name":  name,
+	}
+
+	var response struct {
+		Repository struct {
+			Label *labelNode `json:"label"`
+		} `json:"repository"`
+	}
+
+	if err := graphQLClientFor(client).Named(ctx, "repositoryLabel", variables, &response); err != nil {
+		return labelNode{}, fmt.Errorf("failed to get label %q: %w", name, err)
+	}
+
+	if response.Repository.Label == nil {
+		return labelNode{}, fmt.Errorf("label %q does not exist in %s/%s; create it first with create_scoped_label", name, owner, repo)
+	}
+
+	return *response.Repository.Label, nil
+}
@@ -0,0 +1,112 @@
+package mirror
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// Config holds the tracker credentials and mirroring allow-lists, read from the same
+// viper-backed config as `personal_access_token` (linear.api_key, jira.base_url, ...).
+type Config struct {
+	LinearAPIKey string
+	LinearTeamID string
+
+	JiraBaseURL string
+	JiraUser    string
+	JiraToken   string
+
+	// AllowedLabels, when non-empty, restricts mirroring to issues carrying at least
+	// one of these labels (case-insensitive).
+	AllowedLabels []string
+	// AllowedSeverities, when non-empty, restricts mirroring to issues whose severity
+	// label (the value of a "severity/"-prefixed label) is in this list.
+	AllowedSeverities []string
+}
+
+// loadConfig reads tracker configuration from viper.
+func loadConfig() Config {
+	return Config{
+		LinearAPIKey:      viper.GetString("linear.api_key"),
+		LinearTeamID:      viper.GetString("linear.team_id"),
+		JiraBaseURL:       viper.GetString("jira.base_url"),
+		JiraUser:          viper.GetString("jira.user"),
+		JiraToken:         viper.GetString("jira.token"),
+		AllowedLabels:     viper.GetStringSlice("mirror.allowed_labels"),
+		AllowedSeverities: viper.GetStringSlice("mirror.allowed_severities"),
+	}
+}
+
+// configureTracker holds tracker credentials in viper's in-memory store, for this
+// process's lifetime only, so later mirror_issue and mirror_project calls in the same
+// run can build a Tracker without the caller repeating secrets. Nothing is written to
+// disk; the credentials must be configured again after a restart.
+func configureTracker(tracker string, settings map[string]string) error {
+	switch tracker {
+	case "linear":
+		if apiKey, ok := settings["api_key"]; ok {
+			viper.Set("linear.api_key", apiKey)
+		}
+		if teamID, ok := settings["team_id"]; ok {
+			viper.Set("linear.team_id", teamID)
+		}
+	case "jira":
+		if baseURL, ok := settings["base_url"]; ok {
+			viper.Set("jira.base_url", baseURL)
+		}
+		if user, ok := settings["user"]; ok {
+			viper.Set("jira.user", user)
+		}
+		if token, ok := settings["token"]; ok {
+			viper.Set("jira.token", token)
+		}
+	default:
+		return fmt.Errorf("unsupported tracker: %s", tracker)
+	}
+	return nil
+}
+
+// severityOf returns the value of the first "severity/"-prefixed label on an issue, or
+// "" if none is present.
+func severityOf(labels []string) string {
+	for _, label := range labels {
+		if rest, ok := strings.CutPrefix(label, "severity/"); ok {
+			return rest
+		}
+	}
+	return ""
+}
+
+// allowedByConfig reports whether an issue with the given labels passes the configured
+// label and severity allow-lists. Empty allow-lists permit everything.
+func allowedByConfig(cfg Config, labels []string) bool {
+	if len(cfg.AllowedLabels) > 0 {
+		matched := false
+		for _, label := range labels {
+			for _, allow := range cfg.AllowedLabels {
+				if strings.EqualFold(label, allow) {
+					matched = true
+				}
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if len(cfg.AllowedSeverities) > 0 {
+		severity := severityOf(labels)
+		matched := false
+		for _, allow := range cfg.AllowedSeverities {
+			if strings.EqualFold(severity, allow) {
+				matched = true
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,174 @@
+package mirror
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// JiraTracker mirrors issues into a Jira project via the REST v3 API, authenticated with
+// HTTP Basic auth (a user email + API token, or a PAT in the token field).
+type JiraTracker struct {
+	baseURL    string
+	user       string
+	token      string
+	projectKey string
+	issueType  string
+	httpClient *http.Client
+}
+
+// NewJiraTracker builds a JiraTracker that creates issues of issueType in projectKey.
+func NewJiraTracker(baseURL, user, token, projectKey, issueType string) *JiraTracker {
+	return &JiraTracker{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		user:       user,
+		token:      token,
+		projectKey: projectKey,
+		issueType:  issueType,
+		httpClient: http.DefaultClient,
+	}
+}
+
+// do issues a REST request against the Jira API with Basic auth and decodes the JSON
+// response body into result, if result is non-nil.
+func (j *JiraTracker) do(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal Jira request: %w", err)
+		}
+		reader = bytes.NewBuffer(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, j.baseURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("failed to create Jira request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	credentials := base64.StdEncoding.EncodeToString([]byte(j.user + ":" + j.token))
+	req.Header.Set("Authorization", "Basic "+credentials)
+
+	resp, err := j.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute Jira request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read Jira response: %w", err)
+	}
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("Jira request to %s failed with status %d: %s", path, resp.StatusCode, string(respBody))
+	}
+
+	if result != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return fmt.Errorf("failed to decode Jira response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// adfDocument wraps plain text in the minimal Atlassian Document Format Jira v3 requires
+// for description and comment bodies.
+func adfDocument(text string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":    "doc",
+		"version": 1,
+		"content": []map[string]interface{}{
+			{
+				"type": "paragraph",
+				"content": []map[string]interface{}{
+					{"type": "text", "text": text},
+				},
+			},
+		},
+	}
+}
+
+// CreateIssue implements Tracker.
+func (j *JiraTracker) CreateIssue(ctx context.Context, issue Issue) (string, error) {
+	body := map[string]interface{}{
+		"fields": map[string]interface{}{
+			"project":     map[string]string{"key": j.projectKey},
+			"summary":     issue.Title,
+			"description": adfDocument(issue.Body),
+			"issuetype":   map[string]string{"name": j.issueType},
+		},
+	}
+
+	var response struct {
+		Key string `json:"key"`
+	}
+
+	if err := j.do(ctx, http.MethodPost, "/rest/api/3/issue", body, &response); err != nil {
+		return "", fmt.Errorf("failed to create Jira issue: %w", err)
+	}
+
+	return response.Key, nil
+}
+
+// UpdateIssue implements Tracker.
+func (j *JiraTracker) UpdateIssue(ctx context.Context, externalKey string, issue Issue) error {
+	body := map[string]interface{}{
+		"fields": map[string]interface{}{
+			"summary":     issue.Title,
+			"description": adfDocument(issue.Body),
+		},
+	}
+
+	if err := j.do(ctx, http.MethodPut, "/rest/api/3/issue/"+externalKey, body, nil); err != nil {
+		return fmt.Errorf("failed to update Jira issue: %w", err)
+	}
+
+	return nil
+}
+
+// CloseIssue implements Tracker by transitioning the issue to the first available
+// transition whose name looks like a closing state ("done" or "closed").
+func (j *JiraTracker) CloseIssue(ctx context.Context, externalKey string) error {
+	var transitions struct {
+		Transitions []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"transitions"`
+	}
+
+	if err := j.do(ctx, http.MethodGet, "/rest/api/3/issue/"+externalKey+"/transitions", nil, &transitions); err != nil {
+		return fmt.Errorf("failed to list Jira transitions: %w", err)
+	}
+
+	for _, transition := range transitions.Transitions {
+		name := strings.ToLower(transition.Name)
+		if strings.Contains(name, "done") || strings.Contains(name, "closed") {
+			body := map[string]interface{}{"transition": map[string]string{"id": transition.ID}}
+			if err := j.do(ctx, http.MethodPost, "/rest/api/3/issue/"+externalKey+"/transitions", body, nil); err != nil {
+				return fmt.Errorf("failed to close Jira issue: %w", err)
+			}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no closing transition available for Jira issue %s", externalKey)
+}
+
+// AddComment implements Tracker.
+func (j *JiraTracker) AddComment(ctx context.Context, externalKey string, body string) error {
+	payload := map[string]interface{}{"body": adfDocument(body)}
+
+	if err := j.do(ctx, http.MethodPost, "/rest/api/3/issue/"+externalKey+"/comment", payload, nil); err != nil {
+		return fmt.Errorf("failed to add Jira comment: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package mirror
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// LinearTracker mirrors issues into a Linear team via Linear's GraphQL API, authenticated
+// with a personal or workspace API key (sent as a raw Authorization header, not Bearer).
+type LinearTracker struct {
+	apiKey     string
+	teamID     string
+	httpClient *http.Client
+}
+
+// NewLinearTracker builds a LinearTracker that creates issues in the given team.
+func NewLinearTracker(apiKey, teamID string) *LinearTracker {
+	return &LinearTracker{apiKey: apiKey, teamID: teamID, httpClient: http.DefaultClient}
+}
+
+type linearGraphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// execute issues a GraphQL request against Linear's API and decodes data into result.
+func (l *LinearTracker) execute(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	payload, err := json.Marshal(linearGraphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return fmt.Errorf("failed to marshal Linear request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.linear.app/graphql", bytes.NewBuffer(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create Linear request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", l.apiKey)
+
+	resp, err := l.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute Linear request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read Linear response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Linear request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var envelope struct {
+		Data   interface{} `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors,omitempty"`
+	}
+	envelope.Data = result
+
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return fmt.Errorf("failed to decode Linear response: %w", err)
+	}
+
+	if len(envelope.Errors) > 0 {
+		return fmt.Errorf("Linear errors: %v", envelope.Errors[0].Message)
+	}
+
+	return nil
+}
+
+const linearIssueCreateMutation = `
+mutation($teamId: String!, $title: String!, $description: String!) {
+	issueCreate(input: {teamId: $teamId, title: $title, description: $description}) {
+		issue {
+			id
+		}
+	}
+}`
+
+const linearIssueUpdateMutation = `
+mutation($id: String!, $title: String!, $description: String!) {
+	issueUpdate(id: $id, input: {title: $title, description: $description}) {
+		success
+	}
+}`
+
+const linearTeamCompletedStatesQuery = `
+query($teamId: String!) {
+	team(id: $teamId) {
+		states(filter: {type: {eq: "completed"}}) {
+			nodes {
+				id
+				name
+			}
+		}
+	}
+}`
+
+const linearIssueUpdateStateMutation = `
+mutation($id: String!, $stateId: String!) {
+	issueUpdate(id: $id, input: {stateId: $stateId}) {
+		success
+	}
+}`
+
+const linearCommentCreateMutation = `
+mutation($issueId: String!, $body: String!) {
+	commentCreate(input: {issueId: $issueId, body: $body}) {
+		success
+	}
+}`
+
+// CreateIssue implements Tracker.
+func (l *LinearTracker) CreateIssue(ctx context.Context, issue Issue) (string, error) {
+	variables := map[string]interface{}{
+		"teamId":      l.teamID,
+		"title":       issue.Title,
+		"description": issue.Body,
+	}
+
+	var response struct {
+		IssueCreate struct {
+			Issue struct {
+				ID string `json:"id"`
+			} `json:"issue"`
+		} `json:"issueCreate"`
+	}
+
+	if err := l.execute(ctx, linearIssueCreateMutation, variables, &response); err != nil {
+		return "", fmt.Errorf("failed to create Linear issue: %w", err)
+	}
+
+	return response.IssueCreate.Issue.ID, nil
+}
+
+// UpdateIssue implements Tracker.
+func (l *LinearTracker) UpdateIssue(ctx context.Context, externalKey string, issue Issue) error {
+	variables := map[string]interface{}{
+		"id":          externalKey,
+		"title":       issue.Title,
+		"description": issue.Body,
+	}
+
+	var response struct {
+		IssueUpdate struct {
+			Success bool `json:"success"`
+		} `json:"issueUpdate"`
+	}
+
+	if err := l.execute(ctx, linearIssueUpdateMutation, variables, &response); err != nil {
+		return fmt.Errorf("failed to update Linear issue: %w", err)
+	}
+
+	return nil
+}
+
+// CloseIssue implements Tracker by transitioning the issue to the team's first workflow
+// state of type "completed", rather than archiving it, so the issue still shows up in
+// Linear's history and reporting as done.
+func (l *LinearTracker) CloseIssue(ctx context.Context, externalKey string) error {
+	var states struct {
+		Team struct {
+			States struct {
+				Nodes []struct {
+					ID   string `json:"id"`
+					Name string `json:"name"`
+				} `json:"nodes"`
+			} `json:"states"`
+		} `json:"team"`
+	}
+
+	if err := l.execute(ctx, linearTeamCompletedStatesQuery, map[string]interface{}{"teamId": l.teamID}, &states); err != nil {
+		return fmt.Errorf("failed to list Linear workflow states: %w", err)
+	}
+	if len(states.Team.States.Nodes) == 0 {
+		return fmt.Errorf("no completed workflow state available for Linear team %s", l.teamID)
+	}
+
+	variables := map[string]interface{}{
+		"id":      externalKey,
+		"stateId": states.Team.States.Nodes[0].ID,
+	}
+
+	var response struct {
+		IssueUpdate struct {
+			Success bool `json:"success"`
+		} `json:"issueUpdate"`
+	}
+
+	if err := l.execute(ctx, linearIssueUpdateStateMutation, variables, &response); err != nil {
+		return fmt.Errorf("failed to close Linear issue: %w", err)
+	}
+
+	return nil
+}
+
+// AddComment implements Tracker.
+func (l *LinearTracker) AddComment(ctx context.Context, externalKey string, body string) error {
+	variables := map[string]interface{}{"issueId": externalKey, "body": body}
+
+	var response struct {
+		CommentCreate struct {
+			Success bool `json:"success"`
+		} `json:"commentCreate"`
+	}
+
+	if err := l.execute(ctx, linearCommentCreateMutation, variables, &response); err != nil {
+		return fmt.Errorf("failed to add Linear comment: %w", err)
+	}
+
+	return nil
+}
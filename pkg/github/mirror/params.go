@@ -0,0 +1,60 @@
+package mirror
+
+import (
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/spf13/viper"
+)
+
+// mappingStorePath returns the configured path for the mirror mapping store, falling
+// back to defaultMappingStorePath.
+func mappingStorePath() string {
+	if path := viper.GetString("mirror.store_path"); path != "" {
+		return path
+	}
+	return defaultMappingStorePath
+}
+
+// requiredStringParam fetches a required string argument, mirroring the requiredParam
+// helper used throughout pkg/github.
+func requiredStringParam(request mcp.CallToolRequest, name string) (string, error) {
+	args := request.Params.Arguments
+	raw, ok := args[name]
+	if !ok || raw == nil {
+		return "", fmt.Errorf("missing required parameter: %s", name)
+	}
+	s, ok := raw.(string)
+	if !ok || s == "" {
+		return "", fmt.Errorf("parameter %s must be a non-empty string", name)
+	}
+	return s, nil
+}
+
+// optionalStringParam fetches an optional string argument, returning "" when absent.
+func optionalStringParam(request mcp.CallToolRequest, name string) (string, error) {
+	args := request.Params.Arguments
+	raw, ok := args[name]
+	if !ok || raw == nil {
+		return "", nil
+	}
+	s, ok := raw.(string)
+	if !ok {
+		return "", fmt.Errorf("parameter %s must be a string", name)
+	}
+	return s, nil
+}
+
+// requiredIntParam fetches a required numeric argument as an int.
+func requiredIntParam(request mcp.CallToolRequest, name string) (int, error) {
+	args := request.Params.Arguments
+	raw, ok := args[name]
+	if !ok || raw == nil {
+		return 0, fmt.Errorf("missing required parameter: %s", name)
+	}
+	n, ok := raw.(float64)
+	if !ok {
+		return 0, fmt.Errorf("parameter %s must be a number", name)
+	}
+	return int(n), nil
+}
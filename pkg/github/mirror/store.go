@@ -0,0 +1,78 @@
+package mirror
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// mappingEntry records which external tracker an issue was mirrored to and the key it
+// was mirrored as.
+type mappingEntry struct {
+	Tracker     string `json:"tracker"`
+	ExternalKey string `json:"externalKey"`
+}
+
+// mappingStore is a small JSON-file-backed table of GitHub issue node ID -> external
+// tracker issue key, so re-running a mirror updates the existing issue rather than
+// creating a duplicate.
+type mappingStore struct {
+	mu      sync.Mutex
+	path    string
+	entries map[string]mappingEntry
+}
+
+// defaultMappingStorePath is used when `mirror.store_path` isn't configured.
+const defaultMappingStorePath = "mirror_mapping.json"
+
+// loadMappingStore reads the mapping table from path, or starts with an empty table if
+// the file doesn't exist yet.
+func loadMappingStore(path string) (*mappingStore, error) {
+	if path == "" {
+		path = defaultMappingStorePath
+	}
+
+	store := &mappingStore{path: path, entries: map[string]mappingEntry{}}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mirror mapping store: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &store.entries); err != nil {
+		return nil, fmt.Errorf("failed to decode mirror mapping store: %w", err)
+	}
+
+	return store, nil
+}
+
+// lookup returns the mapping recorded for a GitHub node ID, if one exists.
+func (s *mappingStore) lookup(nodeID string) (mappingEntry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.entries[nodeID]
+	return entry, ok
+}
+
+// record persists the mapping for a GitHub node ID and writes the store back to disk.
+func (s *mappingStore) record(nodeID string, entry mappingEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[nodeID] = entry
+
+	data, err := json.MarshalIndent(s.entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode mirror mapping store: %w", err)
+	}
+
+	if err := os.WriteFile(s.path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write mirror mapping store: %w", err)
+	}
+
+	return nil
+}
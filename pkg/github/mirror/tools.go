@@ -0,0 +1,354 @@
+package mirror
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/github/github-mcp-server/pkg/translations"
+	gogithub "github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// buildTracker constructs the Tracker named by tracker from the current Config.
+func buildTracker(cfg Config, tracker, projectKey, issueType string) (Tracker, error) {
+	switch tracker {
+	case "linear":
+		if cfg.LinearAPIKey == "" || cfg.LinearTeamID == "" {
+			return nil, fmt.Errorf("linear.api_key and linear.team_id must be configured; use configure_tracker first")
+		}
+		return NewLinearTracker(cfg.LinearAPIKey, cfg.LinearTeamID), nil
+	case "jira":
+		if cfg.JiraBaseURL == "" || cfg.JiraUser == "" || cfg.JiraToken == "" {
+			return nil, fmt.Errorf("jira.base_url, jira.user, and jira.token must be configured; use configure_tracker first")
+		}
+		if projectKey == "" || issueType == "" {
+			return nil, fmt.Errorf("project_key and issue_type are required for the jira tracker")
+		}
+		return NewJiraTracker(cfg.JiraBaseURL, cfg.JiraUser, cfg.JiraToken, projectKey, issueType), nil
+	default:
+		return nil, fmt.Errorf("unsupported tracker: %s", tracker)
+	}
+}
+
+// mirrorResult is returned to the MCP caller after a mirror_issue or mirror_project run.
+type mirrorResult struct {
+	NodeID      string `json:"nodeId"`
+	ExternalKey string `json:"externalKey"`
+	Action      string `json:"action"` // "created", "updated", or "skipped"
+	Reason      string `json:"reason,omitempty"`
+}
+
+// mirrorOneIssue mirrors a single *gogithub.Issue into tracker, consulting and updating
+// store so repeat runs update the existing external issue instead of duplicating it.
+func mirrorOneIssue(ctx context.Context, tracker Tracker, store *mappingStore, trackerName string, cfg Config, issue *gogithub.Issue) (mirrorResult, error) {
+	if issue.NodeID == nil {
+		return mirrorResult{}, fmt.Errorf("issue node ID is nil")
+	}
+	nodeID := *issue.NodeID
+
+	labels := make([]string, 0, len(issue.Labels))
+	for _, label := range issue.Labels {
+		if label.Name != nil {
+			labels = append(labels, *label.Name)
+		}
+	}
+
+	if !allowedByConfig(cfg, labels) {
+		return mirrorResult{NodeID: nodeID, Action: "skipped", Reason: "filtered by label/severity allow-list"}, nil
+	}
+
+	title := issue.GetTitle()
+	body := issue.GetBody()
+	state := issue.GetState()
+
+	mirrored := Issue{Title: title, Body: body, Labels: labels, State: state}
+
+	if entry, ok := store.lookup(nodeID); ok {
+		if err := tracker.UpdateIssue(ctx, entry.ExternalKey, mirrored); err != nil {
+			return mirrorResult{}, err
+		}
+		if state == "closed" {
+			if err := tracker.CloseIssue(ctx, entry.ExternalKey); err != nil {
+				return mirrorResult{}, err
+			}
+		}
+		return mirrorResult{NodeID: nodeID, ExternalKey: entry.ExternalKey, Action: "updated"}, nil
+	}
+
+	externalKey, err := tracker.CreateIssue(ctx, mirrored)
+	if err != nil {
+		return mirrorResult{}, err
+	}
+	if err := store.record(nodeID, mappingEntry{Tracker: trackerName, ExternalKey: externalKey}); err != nil {
+		return mirrorResult{}, err
+	}
+
+	return mirrorResult{NodeID: nodeID, ExternalKey: externalKey, Action: "created"}, nil
+}
+
+// MirrorIssue creates a tool that mirrors a single GitHub issue into an external tracker.
+func MirrorIssue(getClient github.GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("mirror_issue",
+			mcp.WithDescription(t("TOOL_MIRROR_ISSUE_DESCRIPTION", "Mirror a GitHub issue into an external tracker (Linear or Jira), creating it on first run and updating it on subsequent runs.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_MIRROR_ISSUE_USER_TITLE", "Mirror issue"),
+				ReadOnlyHint: false,
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue number to mirror"),
+			),
+			mcp.WithString("tracker",
+				mcp.Required(),
+				mcp.Description("External tracker to mirror into"),
+				mcp.Enum("linear", "jira"),
+			),
+			mcp.WithString("project_key",
+				mcp.Description("Jira project key (required when tracker is jira)"),
+			),
+			mcp.WithString("issue_type",
+				mcp.Description("Jira issue type name, e.g. \"Bug\" (required when tracker is jira)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredStringParam(request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			repo, err := requiredStringParam(request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			issueNumber, err := requiredIntParam(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			trackerName, err := requiredStringParam(request, "tracker")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			projectKey, err := optionalStringParam(request, "project_key")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			issueType, err := optionalStringParam(request, "issue_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			cfg := loadConfig()
+			tracker, err := buildTracker(cfg, trackerName, projectKey, issueType)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			issue, _, err := client.Issues.Get(ctx, owner, repo, issueNumber)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get issue: %w", err)
+			}
+
+			store, err := loadMappingStore(mappingStorePath())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			result, err := mirrorOneIssue(ctx, tracker, store, trackerName, cfg, issue)
+			if err != nil {
+				return nil, fmt.Errorf("failed to mirror issue: %w", err)
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// MirrorProject creates a tool that mirrors every open issue in a repository into an
+// external tracker.
+func MirrorProject(getClient github.GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("mirror_project",
+			mcp.WithDescription(t("TOOL_MIRROR_PROJECT_DESCRIPTION", "Mirror every open issue in a repository into an external tracker (Linear or Jira).")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_MIRROR_PROJECT_USER_TITLE", "Mirror project"),
+				ReadOnlyHint: false,
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("tracker",
+				mcp.Required(),
+				mcp.Description("External tracker to mirror into"),
+				mcp.Enum("linear", "jira"),
+			),
+			mcp.WithString("project_key",
+				mcp.Description("Jira project key (required when tracker is jira)"),
+			),
+			mcp.WithString("issue_type",
+				mcp.Description("Jira issue type name, e.g. \"Bug\" (required when tracker is jira)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredStringParam(request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			repo, err := requiredStringParam(request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			trackerName, err := requiredStringParam(request, "tracker")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			projectKey, err := optionalStringParam(request, "project_key")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			issueType, err := optionalStringParam(request, "issue_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			cfg := loadConfig()
+			tracker, err := buildTracker(cfg, trackerName, projectKey, issueType)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			store, err := loadMappingStore(mappingStorePath())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			results := make([]mirrorResult, 0)
+			opts := &gogithub.IssueListByRepoOptions{State: "open"}
+			for {
+				issues, resp, err := client.Issues.ListByRepo(ctx, owner, repo, opts)
+				if err != nil {
+					return nil, fmt.Errorf("failed to list issues: %w", err)
+				}
+
+				for _, issue := range issues {
+					if issue.IsPullRequest() {
+						continue
+					}
+					result, err := mirrorOneIssue(ctx, tracker, store, trackerName, cfg, issue)
+					if err != nil {
+						return nil, fmt.Errorf("failed to mirror issue #%d: %w", issue.GetNumber(), err)
+					}
+					results = append(results, result)
+				}
+
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			r, err := json.Marshal(results)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ConfigureTracker creates a tool that holds external tracker credentials in memory, for
+// the lifetime of this process, for use by mirror_issue and mirror_project. Credentials
+// do not survive a restart; callers must call this tool again after one.
+func ConfigureTracker(t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("configure_tracker",
+			mcp.WithDescription(t("TOOL_CONFIGURE_TRACKER_DESCRIPTION", "Configure credentials for an external issue tracker (Linear or Jira) used by mirror_issue and mirror_project. Credentials are held in memory for this process's lifetime only and must be reconfigured after a restart.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CONFIGURE_TRACKER_USER_TITLE", "Configure tracker"),
+				ReadOnlyHint: false,
+			}),
+			mcp.WithString("tracker",
+				mcp.Required(),
+				mcp.Description("Tracker to configure"),
+				mcp.Enum("linear", "jira"),
+			),
+			mcp.WithString("api_key",
+				mcp.Description("Linear API key (required when tracker is linear)"),
+			),
+			mcp.WithString("team_id",
+				mcp.Description("Linear team ID (required when tracker is linear)"),
+			),
+			mcp.WithString("base_url",
+				mcp.Description("Jira base URL, e.g. \"https://yourorg.atlassian.net\" (required when tracker is jira)"),
+			),
+			mcp.WithString("user",
+				mcp.Description("Jira user email (required when tracker is jira)"),
+			),
+			mcp.WithString("token",
+				mcp.Description("Jira API token or personal access token (required when tracker is jira)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			trackerName, err := requiredStringParam(request, "tracker")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			settings := map[string]string{}
+			for _, key := range []string{"api_key", "team_id", "base_url", "user", "token"} {
+				value, err := optionalStringParam(request, key)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if value != "" {
+					settings[key] = value
+				}
+			}
+
+			if err := configureTracker(trackerName, settings); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			r, err := json.Marshal(map[string]string{"tracker": trackerName, "status": "configured"})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,34 @@
+// Package mirror pushes GitHub issues into an external issue tracker, keeping a mapping
+// table so re-runs update the mirrored issue instead of creating duplicates. Issue
+// comments and pull request review comments are not mirrored.
+package mirror
+
+import "context"
+
+// Issue is the tracker-neutral representation of a GitHub issue being mirrored.
+type Issue struct {
+	Title  string
+	Body   string
+	Labels []string
+	State  string // "open" or "closed"
+}
+
+// Tracker is implemented by each external issue tracker integration. Implementations
+// are responsible for mapping Issue onto their own schema (Linear teams/projects, Jira
+// project keys/issue types, etc).
+type Tracker interface {
+	// CreateIssue creates a new issue in the external tracker and returns its key
+	// (e.g. a Linear issue ID or a Jira issue key like "ABC-123").
+	CreateIssue(ctx context.Context, issue Issue) (externalKey string, err error)
+
+	// UpdateIssue updates the title and body of an already-mirrored issue; Issue.Labels
+	// is not sent to the tracker.
+	UpdateIssue(ctx context.Context, externalKey string, issue Issue) error
+
+	// CloseIssue transitions an already-mirrored issue to its tracker's closed state.
+	CloseIssue(ctx context.Context, externalKey string) error
+
+	// AddComment adds a comment to an already-mirrored issue. mirror_issue and
+	// mirror_project do not call it.
+	AddComment(ctx context.Context, externalKey string, body string) error
+}
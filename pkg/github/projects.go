@@ -1,145 +1,33 @@
 package github
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
+	"strconv"
 
+	"github.com/github/github-mcp-server/pkg/githubgql"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
-	"github.com/spf13/viper"
 )
 
-// graphQLRequest represents a GitHub GraphQL API request
-type graphQLRequest struct {
-	Query     string                 `json:"query"`
-	Variables map[string]interface{} `json:"variables,omitempty"`
+// graphQLClientFor builds a githubgql.Client that reuses client's authenticated
+// *http.Client, so GraphQL requests carry the same credentials as the REST calls made
+// through client. Each call returns a new Client whose registry holds only the default
+// queries, so a query override registered on one Client does not reach later calls.
+func graphQLClientFor(client *github.Client) *githubgql.Client {
+	return githubgql.NewClient(client.Client())
 }
 
-// executeGraphQL executes a GraphQL query against the GitHub API
-func executeGraphQL(ctx context.Context, client *github.Client, query string, variables map[string]interface{}, result interface{}) error {
-	requestBody := graphQLRequest{
-		Query:     query,
-		Variables: variables,
-	}
-
-	payload, err := json.Marshal(requestBody)
-	if err != nil {
-		return fmt.Errorf("failed to marshal GraphQL request: %w", err)
-	}
-
-	req, err := http.NewRequestWithContext(ctx, "POST", "https://api.github.com/graphql", bytes.NewBuffer(payload))
-	if err != nil {
-		return fmt.Errorf("failed to create GraphQL request: %w", err)
-	}
-
-	// Set required headers
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
-	token := viper.GetString("personal_access_token")
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-
-	// Copy authorization header from the client's transport
-	if transport := client.Client().Transport; transport != nil {
-		dummy, _ := http.NewRequest("GET", "", nil)
-		transport.RoundTrip(dummy)
-		if auth := dummy.Header.Get("Authorization"); auth != "" {
-			req.Header.Set("Authorization", auth)
-		}
-	}
-
-	// Use http.DefaultClient to make the request
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to execute GraphQL request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("GraphQL request failed with status %d: %s", resp.StatusCode, string(body))
-	}
-
-	var graphQLResponse struct {
-		Data   interface{} `json:"data"`
-		Errors []struct {
-			Message string `json:"message"`
-		} `json:"errors,omitempty"`
-	}
-	graphQLResponse.Data = result
-
-	bodyBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to read GraphQL response: %w", err)
-	}
-
-	if err := json.Unmarshal(bodyBytes, &graphQLResponse); err != nil {
-		return fmt.Errorf("failed to decode GraphQL response: %w", err)
-	}
-
-	if len(graphQLResponse.Errors) > 0 {
-		return fmt.Errorf("GraphQL errors: %v", graphQLResponse.Errors[0].Message)
-	}
-
-	return nil
+// NewGraphQLClient exposes graphQLClientFor to subpackages (e.g. pkg/github/export and
+// pkg/github/blueprint) that need to issue GraphQL requests through the same authenticated
+// transport as the rest of the github package.
+func NewGraphQLClient(client *github.Client) *githubgql.Client {
+	return graphQLClientFor(client)
 }
 
-// GraphQL queries for GitHub Projects
-const (
-	listProjectsQuery = `
-	query($org: String!, $first: Int, $after: String) {
-		organization(login: $org) {
-			projectsV2(first: $first, after: $after) {
-				nodes {
-					id
-					title
-					shortDescription
-					url
-					closed
-					number
-					items {
-						totalCount
-					}
-				}
-				pageInfo {
-					hasNextPage
-					endCursor
-				}
-			}
-		}
-	}`
-
-	addItemToProjectQuery = `
-	mutation($projectId: ID!, $contentId: ID!) {
-		addProjectV2ItemById(input: {projectId: $projectId, contentId: $contentId}) {
-			item {
-				id
-			}
-		}
-	}`
-
-	updateProjectItemFieldValueQuery = `
-	mutation($projectId: ID!, $itemId: ID!, $fieldId: ID!, $value: ProjectV2FieldValue!) {
-		updateProjectV2ItemFieldValue(
-			input: {
-				projectId: $projectId
-				itemId: $itemId
-				fieldId: $fieldId
-				value: $value
-			}
-		) {
-			projectV2Item {
-				id
-			}
-		}
-	}`
-)
-
 // Project represents a GitHub Project (V2)
 type Project struct {
 	ID               string `json:"id"`
@@ -160,6 +48,50 @@ type ProjectItem struct {
 	ContentID string `json:"contentId,omitempty"`
 }
 
+// ProjectFieldOption represents a single-select option belonging to a ProjectField
+type ProjectFieldOption struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ProjectField represents a field (column) defined on a GitHub Project (V2), including
+// its option IDs so callers can resolve human-readable option names (e.g. "In Progress")
+// to the option ID a single-select mutation requires, without a second round-trip.
+type ProjectField struct {
+	ID       string               `json:"id"`
+	Name     string               `json:"name"`
+	DataType string               `json:"dataType"`
+	Options  []ProjectFieldOption `json:"options,omitempty"`
+}
+
+// ProjectItemSummary represents an item returned from ListProjectItems, including a
+// best-effort summary of its underlying content (issue, pull request, or draft issue).
+type ProjectItemSummary struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	ContentID string `json:"contentId,omitempty"`
+	Number    int    `json:"number,omitempty"`
+	Title     string `json:"title,omitempty"`
+	URL       string `json:"url,omitempty"`
+}
+
+// ProjectView represents a saved view (board, table, or roadmap) on a GitHub Project (V2)
+type ProjectView struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Layout string `json:"layout"`
+}
+
+// projectFieldDataTypes maps the user-facing field type names to the GraphQL
+// ProjectV2CustomFieldType enum values accepted by createProjectV2Field.
+var projectFieldDataTypes = map[string]string{
+	"text":          "TEXT",
+	"number":        "NUMBER",
+	"date":          "DATE",
+	"single_select": "SINGLE_SELECT",
+	"iteration":     "ITERATION",
+}
+
 // ListOrgProjects creates a tool to list projects in an organization
 func ListOrgProjects(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_org_projects",
@@ -226,7 +158,7 @@ func ListOrgProjects(getClient GetClientFn, t translations.TranslationHelperFunc
 				} `json:"organization"`
 			}
 
-			err = executeGraphQL(ctx, client, listProjectsQuery, variables, &response)
+			err = graphQLClientFor(client).Named(ctx, "listProjects", variables, &response)
 			if err != nil {
 				return nil, fmt.Errorf("failed to list organization projects: %w", err)
 			}
@@ -330,7 +262,7 @@ func AddIssueToProject(getClient GetClientFn, t translations.TranslationHelperFu
 				} `json:"addProjectV2ItemById"`
 			}
 
-			err = executeGraphQL(ctx, client, addItemToProjectQuery, variables, &response)
+			err = graphQLClientFor(client).Named(ctx, "addItemToProject", variables, &response)
 			if err != nil {
 				return nil, fmt.Errorf("failed to add issue to project: %w", err)
 			}
@@ -418,7 +350,7 @@ func UpdateProjectItemState(getClient GetClientFn, t translations.TranslationHel
 				} `json:"updateProjectV2ItemFieldValue"`
 			}
 
-			err = executeGraphQL(ctx, client, updateProjectItemFieldValueQuery, variables, &response)
+			err = graphQLClientFor(client).Named(ctx, "updateProjectItemFieldValue", variables, &response)
 			if err != nil {
 				return nil, fmt.Errorf("failed to update project item state: %w", err)
 			}
@@ -436,3 +368,732 @@ func UpdateProjectItemState(getClient GetClientFn, t translations.TranslationHel
 			return mcp.NewToolResultText(string(r)), nil
 		}
 }
+
+// ListProjectFields creates a tool to list the fields (columns) defined on a project
+func ListProjectFields(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_project_fields",
+			mcp.WithDescription(t("TOOL_LIST_PROJECT_FIELDS_DESCRIPTION", "List the fields defined on a GitHub Project (V2), including single-select option IDs.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PROJECT_FIELDS_USER_TITLE", "List project fields"),
+				ReadOnlyHint: true,
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("Project ID (GraphQL node ID)"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			projectID, err := requiredParam[string](request, "project_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			variables := map[string]interface{}{
+				"projectId": projectID,
+				"first":     pagination.perPage,
+			}
+
+			var response struct {
+				Node struct {
+					Fields struct {
+						Nodes []struct {
+							ID       string `json:"id"`
+							Name     string `json:"name"`
+							DataType string `json:"dataType"`
+							Options  []struct {
+								ID   string `json:"id"`
+								Name string `json:"name"`
+							} `json:"options"`
+						} `json:"nodes"`
+						PageInfo struct {
+							HasNextPage bool   `json:"hasNextPage"`
+							EndCursor   string `json:"endCursor"`
+						} `json:"pageInfo"`
+					} `json:"fields"`
+				} `json:"node"`
+			}
+
+			err = graphQLClientFor(client).Named(ctx, "listProjectFields", variables, &response)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list project fields: %w", err)
+			}
+
+			fields := make([]ProjectField, 0, len(response.Node.Fields.Nodes))
+			for _, node := range response.Node.Fields.Nodes {
+				field := ProjectField{
+					ID:       node.ID,
+					Name:     node.Name,
+					DataType: node.DataType,
+				}
+				for _, opt := range node.Options {
+					field.Options = append(field.Options, ProjectFieldOption{ID: opt.ID, Name: opt.Name})
+				}
+				fields = append(fields, field)
+			}
+
+			r, err := json.Marshal(fields)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListProjectItems creates a tool to list the items on a project
+func ListProjectItems(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_project_items",
+			mcp.WithDescription(t("TOOL_LIST_PROJECT_ITEMS_DESCRIPTION", "List the items (issues, pull requests, and draft issues) on a GitHub Project (V2).")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PROJECT_ITEMS_USER_TITLE", "List project items"),
+				ReadOnlyHint: true,
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("Project ID (GraphQL node ID)"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			projectID, err := requiredParam[string](request, "project_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			variables := map[string]interface{}{
+				"projectId": projectID,
+				"first":     pagination.perPage,
+			}
+
+			var response struct {
+				Node struct {
+					Items struct {
+						Nodes []struct {
+							ID      string `json:"id"`
+							Type    string `json:"type"`
+							Content struct {
+								ID     string `json:"id"`
+								Number int    `json:"number"`
+								Title  string `json:"title"`
+								URL    string `json:"url"`
+							} `json:"content"`
+						} `json:"nodes"`
+						PageInfo struct {
+							HasNextPage bool   `json:"hasNextPage"`
+							EndCursor   string `json:"endCursor"`
+						} `json:"pageInfo"`
+					} `json:"items"`
+				} `json:"node"`
+			}
+
+			err = graphQLClientFor(client).Named(ctx, "listProjectItems", variables, &response)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list project items: %w", err)
+			}
+
+			items := make([]ProjectItemSummary, 0, len(response.Node.Items.Nodes))
+			for _, node := range response.Node.Items.Nodes {
+				items = append(items, ProjectItemSummary{
+					ID:        node.ID,
+					Type:      node.Type,
+					ContentID: node.Content.ID,
+					Number:    node.Content.Number,
+					Title:     node.Content.Title,
+					URL:       node.Content.URL,
+				})
+			}
+
+			r, err := json.Marshal(items)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListProjectViews creates a tool to list the saved views defined on a project
+func ListProjectViews(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_project_views",
+			mcp.WithDescription(t("TOOL_LIST_PROJECT_VIEWS_DESCRIPTION", "List the saved views (board, table, roadmap) defined on a GitHub Project (V2).")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PROJECT_VIEWS_USER_TITLE", "List project views"),
+				ReadOnlyHint: true,
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("Project ID (GraphQL node ID)"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			projectID, err := requiredParam[string](request, "project_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			variables := map[string]interface{}{
+				"projectId": projectID,
+				"first":     pagination.perPage,
+			}
+
+			var response struct {
+				Node struct {
+					Views struct {
+						Nodes []struct {
+							ID     string `json:"id"`
+							Name   string `json:"name"`
+							Layout string `json:"layout"`
+						} `json:"nodes"`
+						PageInfo struct {
+							HasNextPage bool   `json:"hasNextPage"`
+							EndCursor   string `json:"endCursor"`
+						} `json:"pageInfo"`
+					} `json:"views"`
+				} `json:"node"`
+			}
+
+			err = graphQLClientFor(client).Named(ctx, "listProjectViews", variables, &response)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list project views: %w", err)
+			}
+
+			views := make([]ProjectView, 0, len(response.Node.Views.Nodes))
+			for _, node := range response.Node.Views.Nodes {
+				views = append(views, ProjectView{ID: node.ID, Name: node.Name, Layout: node.Layout})
+			}
+
+			r, err := json.Marshal(views)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateProjectField creates a tool to add a new field to a project
+func CreateProjectField(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_project_field",
+			mcp.WithDescription(t("TOOL_CREATE_PROJECT_FIELD_DESCRIPTION", "Create a new field (text, number, date, iteration, or single-select) on a GitHub Project (V2).")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_PROJECT_FIELD_USER_TITLE", "Create project field"),
+				ReadOnlyHint: false,
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("Project ID (GraphQL node ID)"),
+			),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Name of the new field"),
+			),
+			mcp.WithString("data_type",
+				mcp.Required(),
+				mcp.Description("Type of field to create"),
+				mcp.Enum("text", "number", "date", "iteration", "single_select"),
+			),
+			mcp.WithArray("options",
+				mcp.Description("Option names for a single_select field (required when data_type is single_select)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			projectID, err := requiredParam[string](request, "project_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			name, err := requiredParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			dataType, err := requiredParam[string](request, "data_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			graphQLDataType, ok := projectFieldDataTypes[dataType]
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("unsupported data_type: %s", dataType)), nil
+			}
+
+			input := map[string]interface{}{
+				"projectId": projectID,
+				"name":      name,
+				"dataType":  graphQLDataType,
+			}
+
+			if dataType == "single_select" {
+				options, err := OptionalParam[[]interface{}](request, "options")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if len(options) == 0 {
+					return mcp.NewToolResultError("options is required when data_type is single_select"), nil
+				}
+
+				singleSelectOptions := make([]map[string]interface{}, 0, len(options))
+				for _, option := range options {
+					singleSelectOptions = append(singleSelectOptions, map[string]interface{}{
+						"name":        option,
+						"color":       "GRAY",
+						"description": "",
+					})
+				}
+				input["singleSelectOptions"] = singleSelectOptions
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			variables := map[string]interface{}{
+				"input": input,
+			}
+
+			var response struct {
+				CreateProjectV2Field struct {
+					ProjectV2Field struct {
+						ID       string `json:"id"`
+						Name     string `json:"name"`
+						DataType string `json:"dataType"`
+						Options  []struct {
+							ID   string `json:"id"`
+							Name string `json:"name"`
+						} `json:"options"`
+					} `json:"projectV2Field"`
+				} `json:"createProjectV2Field"`
+			}
+
+			err = graphQLClientFor(client).Named(ctx, "createProjectField", variables, &response)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create project field: %w", err)
+			}
+
+			field := ProjectField{
+				ID:       response.CreateProjectV2Field.ProjectV2Field.ID,
+				Name:     response.CreateProjectV2Field.ProjectV2Field.Name,
+				DataType: response.CreateProjectV2Field.ProjectV2Field.DataType,
+			}
+			for _, opt := range response.CreateProjectV2Field.ProjectV2Field.Options {
+				field.Options = append(field.Options, ProjectFieldOption{ID: opt.ID, Name: opt.Name})
+			}
+
+			r, err := json.Marshal(field)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// DeleteProjectItem creates a tool to remove an item from a project
+func DeleteProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_project_item",
+			mcp.WithDescription(t("TOOL_DELETE_PROJECT_ITEM_DESCRIPTION", "Remove an item from a GitHub Project (V2).")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_DELETE_PROJECT_ITEM_USER_TITLE", "Delete project item"),
+				ReadOnlyHint: false,
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("Project ID (GraphQL node ID)"),
+			),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Project item ID to remove"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			projectID, err := requiredParam[string](request, "project_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			itemID, err := requiredParam[string](request, "item_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			variables := map[string]interface{}{
+				"projectId": projectID,
+				"itemId":    itemID,
+			}
+
+			var response struct {
+				DeleteProjectV2Item struct {
+					DeletedItemID string `json:"deletedItemId"`
+				} `json:"deleteProjectV2Item"`
+			}
+
+			err = graphQLClientFor(client).Named(ctx, "deleteProjectItem", variables, &response)
+			if err != nil {
+				return nil, fmt.Errorf("failed to delete project item: %w", err)
+			}
+
+			result := ProjectItem{ID: response.DeleteProjectV2Item.DeletedItemID}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ArchiveProjectItem creates a tool to archive an item on a project
+func ArchiveProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("archive_project_item",
+			mcp.WithDescription(t("TOOL_ARCHIVE_PROJECT_ITEM_DESCRIPTION", "Archive an item on a GitHub Project (V2).")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ARCHIVE_PROJECT_ITEM_USER_TITLE", "Archive project item"),
+				ReadOnlyHint: false,
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("Project ID (GraphQL node ID)"),
+			),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Project item ID to archive"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			projectID, err := requiredParam[string](request, "project_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			itemID, err := requiredParam[string](request, "item_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			variables := map[string]interface{}{
+				"projectId": projectID,
+				"itemId":    itemID,
+			}
+
+			var response struct {
+				ArchiveProjectV2Item struct {
+					Item struct {
+						ID string `json:"id"`
+					} `json:"item"`
+				} `json:"archiveProjectV2Item"`
+			}
+
+			err = graphQLClientFor(client).Named(ctx, "archiveProjectItem", variables, &response)
+			if err != nil {
+				return nil, fmt.Errorf("failed to archive project item: %w", err)
+			}
+
+			result := ProjectItem{ID: response.ArchiveProjectV2Item.Item.ID}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// AddDraftIssue creates a tool to add a draft issue to a project
+func AddDraftIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_draft_issue",
+			mcp.WithDescription(t("TOOL_ADD_DRAFT_ISSUE_DESCRIPTION", "Add a draft issue (not backed by a repository issue) to a GitHub Project (V2).")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ADD_DRAFT_ISSUE_USER_TITLE", "Add draft issue"),
+				ReadOnlyHint: false,
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("Project ID (GraphQL node ID)"),
+			),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("Title of the draft issue"),
+			),
+			mcp.WithString("body",
+				mcp.Description("Body of the draft issue"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			projectID, err := requiredParam[string](request, "project_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			title, err := requiredParam[string](request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			body, err := OptionalParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			variables := map[string]interface{}{
+				"projectId": projectID,
+				"title":     title,
+				"body":      body,
+			}
+
+			var response struct {
+				AddProjectV2DraftIssue struct {
+					ProjectItem struct {
+						ID string `json:"id"`
+					} `json:"projectItem"`
+				} `json:"addProjectV2DraftIssue"`
+			}
+
+			err = graphQLClientFor(client).Named(ctx, "addProjectDraftIssue", variables, &response)
+			if err != nil {
+				return nil, fmt.Errorf("failed to add draft issue: %w", err)
+			}
+
+			result := ProjectItem{
+				ID:   response.AddProjectV2DraftIssue.ProjectItem.ID,
+				Type: "DRAFT_ISSUE",
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ConvertDraftToIssue creates a tool to convert a draft issue item into a repository issue
+func ConvertDraftToIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("convert_draft_to_issue",
+			mcp.WithDescription(t("TOOL_CONVERT_DRAFT_TO_ISSUE_DESCRIPTION", "Convert a draft issue item on a GitHub Project (V2) into a real issue in a repository.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CONVERT_DRAFT_TO_ISSUE_USER_TITLE", "Convert draft issue to issue"),
+				ReadOnlyHint: false,
+			}),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Draft issue project item ID to convert"),
+			),
+			mcp.WithString("repository_id",
+				mcp.Required(),
+				mcp.Description("Repository ID (GraphQL node ID) the new issue should be created in"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			itemID, err := requiredParam[string](request, "item_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			repositoryID, err := requiredParam[string](request, "repository_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			variables := map[string]interface{}{
+				"itemId":       itemID,
+				"repositoryId": repositoryID,
+			}
+
+			var response struct {
+				ConvertProjectV2DraftIssueItemToIssue struct {
+					Item struct {
+						ID string `json:"id"`
+					} `json:"item"`
+				} `json:"convertProjectV2DraftIssueItemToIssue"`
+			}
+
+			err = graphQLClientFor(client).Named(ctx, "convertDraftIssueToIssue", variables, &response)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert draft issue to issue: %w", err)
+			}
+
+			result := ProjectItem{
+				ID:   response.ConvertProjectV2DraftIssueItemToIssue.Item.ID,
+				Type: "ISSUE",
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// UpdateProjectItemField creates a tool to update any field on a project item, dispatching
+// on field_type rather than assuming a single-select field as UpdateProjectItemState does.
+func UpdateProjectItemField(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_project_item_field",
+			mcp.WithDescription(t("TOOL_UPDATE_PROJECT_ITEM_FIELD_DESCRIPTION", "Update a field value (text, number, date, iteration, or single-select) on a GitHub Project (V2) item.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_PROJECT_ITEM_FIELD_USER_TITLE", "Update project item field"),
+				ReadOnlyHint: false,
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("Project ID (GraphQL node ID)"),
+			),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Project item ID to update"),
+			),
+			mcp.WithString("field_id",
+				mcp.Required(),
+				mcp.Description("Field ID to update"),
+			),
+			mcp.WithString("field_type",
+				mcp.Required(),
+				mcp.Description("Type of the field being updated, which determines how value is interpreted"),
+				mcp.Enum("text", "number", "date", "iteration", "single_select"),
+			),
+			mcp.WithString("value",
+				mcp.Required(),
+				mcp.Description("New value for the field: raw text, a numeric string, an ISO 8601 date, or an option/iteration ID"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			projectID, err := requiredParam[string](request, "project_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			itemID, err := requiredParam[string](request, "item_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			fieldID, err := requiredParam[string](request, "field_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			fieldType, err := requiredParam[string](request, "field_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			value, err := requiredParam[string](request, "value")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			var fieldValue map[string]interface{}
+			switch fieldType {
+			case "text":
+				fieldValue = map[string]interface{}{"text": value}
+			case "number":
+				number, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("value %q is not a valid number", value)), nil
+				}
+				fieldValue = map[string]interface{}{"number": number}
+			case "date":
+				fieldValue = map[string]interface{}{"date": value}
+			case "iteration":
+				fieldValue = map[string]interface{}{"iterationId": value}
+			case "single_select":
+				fieldValue = map[string]interface{}{"singleSelectOptionId": value}
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("unsupported field_type: %s", fieldType)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			variables := map[string]interface{}{
+				"projectId": projectID,
+				"itemId":    itemID,
+				"fieldId":   fieldID,
+				"value":     fieldValue,
+			}
+
+			var response struct {
+				UpdateProjectV2ItemFieldValue struct {
+					ProjectV2Item struct {
+						ID string `json:"id"`
+					} `json:"projectV2Item"`
+				} `json:"updateProjectV2ItemFieldValue"`
+			}
+
+			err = graphQLClientFor(client).Named(ctx, "updateProjectItemFieldValue", variables, &response)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update project item field: %w", err)
+			}
+
+			result := ProjectItem{
+				ID:      response.UpdateProjectV2ItemFieldValue.ProjectV2Item.ID,
+				FieldID: fieldID,
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,67 @@
+package githubgql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// BatchItem is one aliased subquery executed as part of a Client.Batch call. Selection
+// is a single top-level field selection (e.g. "repository(owner: $owner_0, name:
+// $repo_0) { id }"); variable names referenced inside it must be namespaced uniquely
+// across the batch (the _0, _1, ... suffixes below are a convention, not a requirement).
+type BatchItem struct {
+	Alias         string
+	Selection     string
+	VariableTypes map[string]string // e.g. {"$owner_0": "String!"}
+	Variables     map[string]interface{}
+	Result        interface{}
+}
+
+// Batch combines multiple named subqueries into a single POST by aliasing each one at
+// the top level, then demultiplexes the response back into each item's Result. This
+// trades one round-trip for several, which matters when a caller needs many independent
+// lookups (e.g. resolving several labels or several project fields) in one tool call.
+func (c *Client) Batch(ctx context.Context, items []BatchItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	var varDefs []string
+	variables := map[string]interface{}{}
+	var selections []string
+
+	for _, item := range items {
+		for name, gqlType := range item.VariableTypes {
+			varDefs = append(varDefs, fmt.Sprintf("%s: %s", name, gqlType))
+		}
+		for name, value := range item.Variables {
+			variables[name] = value
+		}
+		selections = append(selections, fmt.Sprintf("%s: %s", item.Alias, item.Selection))
+	}
+
+	query := fmt.Sprintf("query(%s) {\n%s\n\trateLimit { cost remaining resetAt }\n}",
+		strings.Join(varDefs, ", "), strings.Join(selections, "\n"))
+
+	raw := map[string]json.RawMessage{}
+	if err := c.Do(ctx, query, variables, &raw); err != nil {
+		return fmt.Errorf("failed to execute batched GraphQL request: %w", err)
+	}
+
+	for _, item := range items {
+		if item.Result == nil {
+			continue
+		}
+		data, ok := raw[item.Alias]
+		if !ok {
+			return fmt.Errorf("batched response missing alias %q", item.Alias)
+		}
+		if err := json.Unmarshal(data, item.Result); err != nil {
+			return fmt.Errorf("failed to decode batched result for alias %q: %w", item.Alias, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,288 @@
+// Package githubgql is a rate-limit-aware GraphQL client for the GitHub API, shared by
+// every pkg/github tool that needs to issue GraphQL requests instead of ad-hoc
+// http.Client calls.
+package githubgql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultEndpoint is the GraphQL endpoint used unless overridden via WithEndpoint, e.g.
+// for a GitHub Enterprise Server instance.
+const DefaultEndpoint = "https://api.github.com/graphql"
+
+const (
+	maxRetries             = 5
+	lowRemainingThreshold  = 50
+	baseBackoff            = 500 * time.Millisecond
+	defaultSecondaryWindow = time.Minute
+)
+
+// Client executes GraphQL requests against the GitHub API over a caller-supplied
+// *http.Client (so it reuses whatever authenticated transport github.Client already
+// has, rather than hitting http.DefaultClient unauthenticated). It retries transient
+// failures with backoff and throttles ahead of the GraphQL and REST rate limits.
+type Client struct {
+	httpClient *http.Client
+	endpoint   string
+	registry   *QueryRegistry
+}
+
+// NewClient builds a Client that issues requests through httpClient. Pass the
+// *http.Client returned by a *github.Client's Client() method to reuse its auth.
+func NewClient(httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{httpClient: httpClient, endpoint: DefaultEndpoint, registry: NewQueryRegistry()}
+}
+
+// WithEndpoint overrides the GraphQL endpoint, for GitHub Enterprise Server instances.
+func (c *Client) WithEndpoint(endpoint string) *Client {
+	c.endpoint = endpoint
+	return c
+}
+
+// Registry returns the client's query registry, so callers can Register overrides for
+// GitHub Enterprise schema differences before issuing requests.
+func (c *Client) Registry() *QueryRegistry {
+	return c.registry
+}
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphQLError struct {
+	Message string `json:"message"`
+	Type    string `json:"type"`
+}
+
+type rateLimitSelection struct {
+	Cost      int    `json:"cost"`
+	Remaining int    `json:"remaining"`
+	ResetAt   string `json:"resetAt"`
+}
+
+// Do executes a raw GraphQL query/mutation, decoding its "data" field into result. It
+// retries on 502/503/504 responses and abuse/secondary-rate-limit errors with
+// exponential backoff and jitter, and throttles ahead of the GraphQL rate limit when the
+// query's selection set includes `rateLimit { cost remaining resetAt }`.
+func (c *Client) Do(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	var lastErr error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			if err := sleepWithJitter(ctx, attempt); err != nil {
+				return err
+			}
+		}
+
+		wait, retryable, err := c.doOnce(ctx, query, variables, result)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+
+		if !retryable {
+			return err
+		}
+
+		if wait > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+		}
+	}
+	return fmt.Errorf("exceeded %d retries: %w", maxRetries, lastErr)
+}
+
+// Named executes the query registered under name in this Client's registry, so a query
+// overridden via Registry().Register on this Client is used without changing call sites.
+// Overrides do not carry over to other Clients.
+func (c *Client) Named(ctx context.Context, name string, variables map[string]interface{}, result interface{}) error {
+	query, ok := c.registry.Get(name)
+	if !ok {
+		return fmt.Errorf("no query registered under name %q", name)
+	}
+	return c.Do(ctx, query, variables, result)
+}
+
+// doOnce issues a single HTTP round-trip. retryable reports whether the caller should
+// retry (with wait as the minimum backoff to honor, from Retry-After or a rate-limit
+// reset); err is non-nil whenever the call didn't fully succeed.
+func (c *Client) doOnce(ctx context.Context, query string, variables map[string]interface{}, result interface{}) (wait time.Duration, retryable bool, err error) {
+	payload, err := json.Marshal(graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to marshal GraphQL request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewBuffer(payload))
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to create GraphQL request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to execute GraphQL request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read GraphQL response: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return retryAfterFromHeader(resp.Header), true, fmt.Errorf("GraphQL request failed with status %d: %s", resp.StatusCode, string(body))
+	case http.StatusForbidden, http.StatusTooManyRequests:
+		msg := strings.ToLower(string(body))
+		rateLimited := resp.StatusCode == http.StatusTooManyRequests || resp.Header.Get("Retry-After") != "" ||
+			resp.Header.Get("X-RateLimit-Remaining") == "0" || strings.Contains(msg, "rate limit") || strings.Contains(msg, "abuse")
+		if rateLimited {
+			wait := retryAfterFromHeader(resp.Header)
+			if wait == 0 {
+				wait = defaultSecondaryWindow
+			}
+			return wait, true, fmt.Errorf("rate limit hit (status %d): %s", resp.StatusCode, string(body))
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, false, fmt.Errorf("GraphQL request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var envelope struct {
+		Data   interface{}    `json:"data"`
+		Errors []graphQLError `json:"errors,omitempty"`
+	}
+	envelope.Data = result
+
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return 0, false, fmt.Errorf("failed to decode GraphQL response: %w", err)
+	}
+
+	if len(envelope.Errors) > 0 {
+		msg := strings.ToLower(envelope.Errors[0].Message)
+		if strings.Contains(msg, "abuse") || strings.Contains(msg, "secondary rate limit") {
+			return defaultSecondaryWindow, true, fmt.Errorf("GraphQL errors: %s", envelope.Errors[0].Message)
+		}
+		return 0, false, fmt.Errorf("GraphQL errors: %s", envelope.Errors[0].Message)
+	}
+
+	if wait := restRateLimitWait(resp.Header); wait > 0 {
+		select {
+		case <-ctx.Done():
+			return 0, false, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+
+	if wait := graphQLRateLimitWait(body); wait > 0 {
+		select {
+		case <-ctx.Done():
+			return 0, false, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+
+	return 0, false, nil
+}
+
+// restRateLimitWait inspects GitHub's REST-style rate-limit headers and returns how long
+// to wait before the next request, or 0 if remaining capacity is healthy.
+func restRateLimitWait(header http.Header) time.Duration {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil || remaining >= lowRemainingThreshold {
+		return 0
+	}
+
+	resetUnix, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	wait := time.Until(time.Unix(resetUnix, 0))
+	if wait < 0 {
+		return 0
+	}
+	return wait
+}
+
+// graphQLRateLimitWait inspects the `rateLimit { remaining resetAt }` field, when a
+// query requested it, and returns how long to wait before the budget resets.
+func graphQLRateLimitWait(body []byte) time.Duration {
+	var peek struct {
+		Data struct {
+			RateLimit *rateLimitSelection `json:"rateLimit"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &peek); err != nil || peek.Data.RateLimit == nil {
+		return 0
+	}
+
+	info := peek.Data.RateLimit
+	if info.Remaining >= lowRemainingThreshold {
+		return 0
+	}
+
+	resetAt, err := time.Parse(time.RFC3339, info.ResetAt)
+	if err != nil {
+		return 0
+	}
+
+	wait := time.Until(resetAt)
+	if wait < 0 {
+		return 0
+	}
+	return wait
+}
+
+// retryAfterFromHeader parses a Retry-After header (seconds or HTTP-date), returning 0
+// if absent or unparsable.
+func retryAfterFromHeader(header http.Header) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if when, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(when); wait > 0 {
+			return wait
+		}
+	}
+
+	return 0
+}
+
+// sleepWithJitter backs off exponentially by attempt number, with up to 250ms of jitter
+// to avoid retry storms against the same rate-limit window.
+func sleepWithJitter(ctx context.Context, attempt int) error {
+	backoff := baseBackoff * time.Duration(1<<uint(attempt-1))
+	jitter := time.Duration(rand.Int63n(int64(250 * time.Millisecond)))
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(backoff + jitter):
+		return nil
+	}
+}
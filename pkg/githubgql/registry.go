@@ -0,0 +1,314 @@
+package githubgql
+
+import "sync"
+
+// QueryRegistry holds named GraphQL query/mutation texts so callers can override a
+// query (e.g. to work around a GitHub Enterprise schema difference) without touching
+// every call site that uses it.
+type QueryRegistry struct {
+	mu      sync.RWMutex
+	queries map[string]string
+}
+
+// NewQueryRegistry returns a registry seeded with the default queries this package
+// ships for GitHub Projects V2 and label operations.
+func NewQueryRegistry() *QueryRegistry {
+	r := &QueryRegistry{queries: make(map[string]string, len(defaultQueries))}
+	for name, query := range defaultQueries {
+		r.queries[name] = query
+	}
+	return r
+}
+
+// Get returns the query registered under name.
+func (r *QueryRegistry) Get(name string) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	query, ok := r.queries[name]
+	return query, ok
+}
+
+// Register overrides (or adds) the query text for name.
+func (r *QueryRegistry) Register(name, query string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.queries[name] = query
+}
+
+// defaultQueries are the GraphQL documents embedded by this package. Query documents
+// include a rateLimit selection so Client.Do can throttle ahead of exhausting the
+// GraphQL budget; mutation documents omit it, since GitHub's Mutation root type has no
+// rateLimit field (only Query does).
+var defaultQueries = map[string]string{
+	"listProjects": `
+	query($org: String!, $first: Int, $after: String) {
+		organization(login: $org) {
+			projectsV2(first: $first, after: $after) {
+				nodes {
+					id
+					title
+					shortDescription
+					url
+					closed
+					number
+					items {
+						totalCount
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+		rateLimit {
+			cost
+			remaining
+			resetAt
+		}
+	}`,
+
+	"addItemToProject": `
+	mutation($projectId: ID!, $contentId: ID!) {
+		addProjectV2ItemById(input: {projectId: $projectId, contentId: $contentId}) {
+			item {
+				id
+			}
+		}
+	}`,
+
+	"updateProjectItemFieldValue": `
+	mutation($projectId: ID!, $itemId: ID!, $fieldId: ID!, $value: ProjectV2FieldValue!) {
+		updateProjectV2ItemFieldValue(
+			input: {
+				projectId: $projectId
+				itemId: $itemId
+				fieldId: $fieldId
+				value: $value
+			}
+		) {
+			projectV2Item {
+				id
+			}
+		}
+	}`,
+
+	"listProjectFields": `
+	query($projectId: ID!, $first: Int, $after: String) {
+		node(id: $projectId) {
+			... on ProjectV2 {
+				fields(first: $first, after: $after) {
+					nodes {
+						... on ProjectV2FieldCommon {
+							id
+							name
+							dataType
+						}
+						... on ProjectV2SingleSelectField {
+							options {
+								id
+								name
+							}
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+		rateLimit {
+			cost
+			remaining
+			resetAt
+		}
+	}`,
+
+	"listProjectItems": `
+	query($projectId: ID!, $first: Int, $after: String) {
+		node(id: $projectId) {
+			... on ProjectV2 {
+				items(first: $first, after: $after) {
+					nodes {
+						id
+						type
+						content {
+							... on Issue {
+								id
+								number
+								title
+								url
+							}
+							... on PullRequest {
+								id
+								number
+								title
+								url
+							}
+							... on DraftIssue {
+								title
+							}
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+		rateLimit {
+			cost
+			remaining
+			resetAt
+		}
+	}`,
+
+	"listProjectViews": `
+	query($projectId: ID!, $first: Int, $after: String) {
+		node(id: $projectId) {
+			... on ProjectV2 {
+				views(first: $first, after: $after) {
+					nodes {
+						id
+						name
+						layout
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+		rateLimit {
+			cost
+			remaining
+			resetAt
+		}
+	}`,
+
+	"createProjectField": `
+	mutation($input: CreateProjectV2FieldInput!) {
+		createProjectV2Field(input: $input) {
+			projectV2Field {
+				... on ProjectV2FieldCommon {
+					id
+					name
+					dataType
+				}
+				... on ProjectV2SingleSelectField {
+					options {
+						id
+						name
+					}
+				}
+			}
+		}
+	}`,
+
+	"deleteProjectItem": `
+	mutation($projectId: ID!, $itemId: ID!) {
+		deleteProjectV2Item(input: {projectId: $projectId, itemId: $itemId}) {
+			deletedItemId
+		}
+	}`,
+
+	"archiveProjectItem": `
+	mutation($projectId: ID!, $itemId: ID!) {
+		archiveProjectV2Item(input: {projectId: $projectId, itemId: $itemId}) {
+			item {
+				id
+			}
+		}
+	}`,
+
+	"addProjectDraftIssue": `
+	mutation($projectId: ID!, $title: String!, $body: String) {
+		addProjectV2DraftIssue(input: {projectId: $projectId, title: $title, body: $body}) {
+			projectItem {
+				id
+			}
+		}
+	}`,
+
+	"convertDraftIssueToIssue": `
+	mutation($itemId: ID!, $repositoryId: ID!) {
+		convertProjectV2DraftIssueItemToIssue(input: {itemId: $itemId, repositoryId: $repositoryId}) {
+			item {
+				id
+			}
+		}
+	}`,
+
+	"issuableLabels": `
+	query($owner: String!, $repo: String!, $number: Int!) {
+		repository(owner: $owner, name: $repo) {
+			issueOrPullRequest(number: $number) {
+				... on Issue {
+					id
+					labels(first: 100) {
+						nodes {
+							id
+							name
+							description
+						}
+					}
+				}
+				... on PullRequest {
+					id
+					labels(first: 100) {
+						nodes {
+							id
+							name
+							description
+						}
+					}
+				}
+			}
+		}
+		rateLimit {
+			cost
+			remaining
+			resetAt
+		}
+	}`,
+
+	"repositoryLabel": `
+	query($owner: String!, $repo: String!, $name: String!) {
+		repository(owner: $owner, name: $repo) {
+			label(name: $name) {
+				id
+				name
+				description
+			}
+		}
+		rateLimit {
+			cost
+			remaining
+			resetAt
+		}
+	}`,
+
+	"setScopedLabel": `
+	mutation($labelableId: ID!, $addLabelIds: [ID!]!, $removeLabelIds: [ID!]!) {
+		addLabelsToLabelable(input: {labelableId: $labelableId, labelIds: $addLabelIds}) {
+			clientMutationId
+		}
+		removeLabelsFromLabelable(input: {labelableId: $labelableId, labelIds: $removeLabelIds}) {
+			clientMutationId
+		}
+	}`,
+
+	"createLabel": `
+	mutation($repositoryId: ID!, $name: String!, $color: String!, $description: String) {
+		createLabel(input: {repositoryId: $repositoryId, name: $name, color: $color, description: $description}) {
+			label {
+				id
+				name
+				description
+			}
+		}
+	}`,
+}